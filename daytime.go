@@ -1,9 +1,14 @@
 package daytime
 
 import (
+	"context"
 	"database/sql/driver"
+	"encoding/xml"
+	"flag"
 	"fmt"
-	"regexp"
+	"io"
+	"log/slog"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -14,112 +19,1167 @@ import (
 const (
 	Day         = 24 * time.Hour
 	DefaultTime = "00:00"
+
+	secondsInDay = int(Day / time.Second)
+
+	// binaryVersion is the first byte of the binary form, it is changed
+	// along with the layout of the binary form.
+	binaryVersion = 1
+	binaryLength  = 8
+)
+
+var (
+	// nowFunc is the source of the current time, it is replaced in tests.
+	nowFunc = time.Now
+
+	ErrObjIsNil   = errors.New("object is nil")
+	ErrInvalid    = errors.New("invalid")
+	ErrUnexpected = errors.New("unexpected")
+
+	// zoneAbbreviations resolves the abbreviations unknown to time.LoadLocation.
+	zoneAbbreviations = map[string]*time.Location{
+		"GMT": time.UTC,
+		"MSK": time.FixedZone("MSK", 3*60*60),
+	}
 )
 
-var (
-	daytimeRegex = regexp.MustCompile(`^\d\d:\d\d(:\d\d){0,1}$`)
+type DayTime struct {
+	hour       int
+	minute     int
+	second     int
+	nanosecond int
+}
+
+// New create a new daytime.
+func New(hour int, minute int, second int) (DayTime, error) {
+	return NewWithNanos(hour, minute, second, 0)
+}
+
+// Normalize create a daytime wrapping out of range components instead of
+// failing, e.g. 25:70:90 becomes 02:11:30 and -1:00:00 becomes 23:00:00.
+// The overflow of seconds and minutes is carried up, the hours wrap modulo 24.
+func Normalize(hour int, minute int, second int) DayTime {
+	seconds := (hour*3600 + minute*60 + second) % secondsInDay
+	if seconds < 0 {
+		seconds += secondsInDay
+	}
+
+	return fromSeconds(seconds)
+}
+
+// NewWithNanos create a new daytime with fractional seconds.
+func NewWithNanos(hour int, minute int, second int, nanosecond int) (DayTime, error) {
+	daytime := DayTime{
+		hour:       hour,
+		minute:     minute,
+		second:     second,
+		nanosecond: nanosecond,
+	}
+	if err := daytime.validate(); err != nil {
+		return DayTime{}, err
+	}
+
+	return daytime, nil
+}
+
+// Validate checks the ranges of the components, it returns ErrInvalid
+// if any of them is out of range. A nil daytime and the end of the day
+// 24:00 are valid.
+func (t *DayTime) Validate() error {
+	if t == nil || t.isEndOfDay() {
+		return nil
+	}
+
+	return t.validate()
+}
+
+// validate checks the ranges of the components of the clock
+// from 00:00 to 23:59:59.999999999.
+func (t *DayTime) validate() error {
+	if t.hour < 0 || t.hour > 23 {
+		return errors.Wrap(ErrInvalid, fmt.Sprintf("value of hour is %d", t.hour))
+	}
+	if t.minute < 0 || t.minute > 59 {
+		return errors.Wrap(ErrInvalid, fmt.Sprintf("value of minute is %d", t.minute))
+	}
+	if t.second < 0 || t.second > 59 {
+		return errors.Wrap(ErrInvalid, fmt.Sprintf("value of second is %d", t.second))
+	}
+	if t.nanosecond < 0 || t.nanosecond > 999999999 {
+		return errors.Wrap(ErrInvalid, fmt.Sprintf("value of nanosecond is %d", t.nanosecond))
+	}
+
+	return nil
+}
+
+// isEndOfDay reports whether the daytime is the end of the day 24:00.
+func (t *DayTime) isEndOfDay() bool {
+	return t != nil && *t == DayTime{hour: 24}
+}
+
+// Parse parse a daytime in the HH:MM or HH:MM:SS form,
+// the seconds may have a fractional part, e.g. 01:02:03.250.
+// The fractional separator may also be a comma, e.g. 01:02:03,250.
+func Parse(value string) (DayTime, error) {
+	value = strings.Trim(value, " \t")
+
+	hour, minute, second, nanosecond, ok := parseClock(value)
+	if !ok {
+		return DayTime{}, errors.Wrap(ErrInvalid, fmt.Sprintf("value '%s'", value))
+	}
+
+	return NewWithNanos(hour, minute, second, nanosecond)
+}
+
+// IsValidString reports whether Parse would succeed for the value,
+// without allocating an error.
+func IsValidString(value string) bool {
+	hour, minute, second, _, ok := parseClock(strings.Trim(value, " \t"))
+
+	return ok && hour <= 23 && minute <= 59 && second <= 59
+}
+
+// ParseStrict parse a daytime like Parse but demands the exact shape:
+// HH:MM:SS if the seconds are required, otherwise HH:MM.
+func ParseStrict(value string, requireSeconds bool) (DayTime, error) {
+	components := 2
+	if requireSeconds {
+		components = 3
+	}
+
+	if strings.Count(value, ":")+1 != components {
+		return DayTime{}, errors.Wrap(ErrInvalid, fmt.Sprintf("number of components of value '%s'", value))
+	}
+
+	return Parse(value)
+}
+
+// ParseAllowEndOfDay parse a daytime like Parse but also accepts 24:00 and
+// 24:00:00 as the end of the day, e.g. for the end of an interval. The end of
+// the day is after any other daytime and is not equal to 00:00, though both
+// are midnight, see IsMidnight. The decoders such as UnmarshalJSON and Scan
+// parse by Parse and reject the end of the day, decode it by this function.
+func ParseAllowEndOfDay(value string) (DayTime, error) {
+	trimmed := strings.Trim(value, " \t")
+	if trimmed == "24:00" || trimmed == "24:00:00" {
+		return DayTime{hour: 24}, nil
+	}
+
+	return Parse(value)
+}
+
+// MustParse is like Parse but panics if the value cannot be parsed.
+func MustParse(value string) DayTime {
+	daytime, err := Parse(value)
+	if err != nil {
+		panic(errors.Wrap(err, "parse"))
+	}
+
+	return daytime
+}
+
+// MustParseAllowEndOfDay is like ParseAllowEndOfDay but panics if the value
+// cannot be parsed.
+func MustParseAllowEndOfDay(value string) DayTime {
+	daytime, err := ParseAllowEndOfDay(value)
+	if err != nil {
+		panic(errors.Wrap(err, "parse"))
+	}
+
+	return daytime
+}
+
+// ParseLenient parse a daytime like Parse but also accepts one-digit
+// components, e.g. 9:05 or 9:5:3.
+func ParseLenient(value string) (DayTime, error) {
+	value = strings.Trim(value, " \t")
+
+	hour, minute, second, ok := parseLenientClock(value)
+	if !ok {
+		return DayTime{}, errors.Wrap(ErrInvalid, fmt.Sprintf("value '%s'", value))
+	}
+
+	return New(hour, minute, second)
+}
+
+// Parse12 parse a daytime in the 12-hour clock, e.g. 1:02 AM or 11:30:15 pm.
+// The meridiem is case-insensitive, 12 AM is midnight and 12 PM is noon.
+func Parse12(value string) (DayTime, error) {
+	value = strings.Trim(value, " \t")
+	if len(value) < 2 {
+		return DayTime{}, errors.Wrap(ErrInvalid, fmt.Sprintf("value '%s'", value))
+	}
+
+	suffix := strings.ToUpper(value[len(value)-2:])
+	if suffix != "AM" && suffix != "PM" {
+		return DayTime{}, errors.Wrap(ErrInvalid, fmt.Sprintf("meridiem of value '%s'", value))
+	}
+
+	clock := strings.TrimRight(value[:len(value)-2], " \t")
+	if len(clock) > 1 && clock[1] == ':' {
+		clock = "0" + clock
+	}
+
+	hour, minute, second, nanosecond, ok := parseClock(clock)
+	if !ok || hour < 1 || hour > 12 {
+		return DayTime{}, errors.Wrap(ErrInvalid, fmt.Sprintf("value '%s'", value))
+	}
+
+	hour %= 12
+	if suffix == "PM" {
+		hour += 12
+	}
+
+	return NewWithNanos(hour, minute, second, nanosecond)
+}
+
+// ParseWithZone parse a daytime followed by an optional time zone, e.g.
+// 09:00 UTC or 17:30 Europe/Moscow. The zone is an IANA name or one of
+// a few abbreviations such as MSK. The location is nil if no zone is given.
+func ParseWithZone(value string) (DayTime, *time.Location, error) {
+	value = strings.Trim(value, " \t")
+
+	clock, zone, found := strings.Cut(value, " ")
+	if !found {
+		daytime, err := Parse(value)
+
+		return daytime, nil, err
+	}
+
+	daytime, err := Parse(clock)
+	if err != nil {
+		return DayTime{}, nil, err
+	}
+
+	zone = strings.Trim(zone, " \t")
+	loc, ok := zoneAbbreviations[zone]
+	if !ok {
+		loc, err = time.LoadLocation(zone)
+		if err != nil || zone == "" {
+			return DayTime{}, nil, errors.Wrap(ErrInvalid, fmt.Sprintf("zone '%s'", zone))
+		}
+	}
+
+	return daytime, loc, nil
+}
+
+// ParseWithOffset parse a daytime followed by an optional UTC offset in the
+// ±HH:MM or Z form, e.g. 09:00+03:00 or 09:00Z, and converts it to UTC,
+// so 09:00+03:00 gives 06:00. The result wraps around midnight. A daytime
+// without an offset is returned as is.
+func ParseWithOffset(value string) (DayTime, error) {
+	value = strings.Trim(value, " \t")
+
+	i := strings.IndexAny(value, "Z+-")
+	if i < 0 {
+		return Parse(value)
+	}
+
+	daytime, err := Parse(value[:i])
+	if err != nil {
+		return DayTime{}, err
+	}
+
+	offset := value[i:]
+	if offset == "Z" {
+		return daytime, nil
+	}
+
+	if len(offset) != 6 || offset[0] == 'Z' || offset[3] != ':' {
+		return DayTime{}, errors.Wrap(ErrInvalid, fmt.Sprintf("offset '%s'", offset))
+	}
+
+	hours, ok := parseTwoDigits(offset[1:3])
+	if !ok || hours > 23 {
+		return DayTime{}, errors.Wrap(ErrInvalid, fmt.Sprintf("offset '%s'", offset))
+	}
+
+	minutes, ok := parseTwoDigits(offset[4:6])
+	if !ok || minutes > 59 {
+		return DayTime{}, errors.Wrap(ErrInvalid, fmt.Sprintf("offset '%s'", offset))
+	}
+
+	d := time.Duration(hours)*time.Hour + time.Duration(minutes)*time.Minute
+	if offset[0] == '+' {
+		d = -d
+	}
+
+	return daytime.Add(d), nil
+}
+
+// ParseReader parse a daytime token in the HH:MM or HH:MM:SS form from the
+// reader, e.g. in a larger grammar. The runes of the token are consumed, the
+// rest is left in the reader. A colon after HH:MM is taken as the start of
+// the seconds only if a digit follows it, otherwise HH:MM is returned.
+//
+// An io.RuneScanner can unread only the last rune, so the reader may lose
+// runes read ahead: on an error the runes before the offending one are
+// consumed, e.g. 9 of 9:00, and the colon of 09:00: followed by a non-digit
+// is consumed. A reader with a Peek method such as bufio.Reader keeps that
+// colon.
+func ParseReader(r io.RuneScanner) (DayTime, error) {
+	token := make([]byte, 0, 8)
+
+	for i := 0; i < 5; i++ {
+		c, err := readTokenByte(r, i != 2)
+		if err != nil {
+			return DayTime{}, errors.Wrap(err, fmt.Sprintf("token '%s'", token))
+		}
+		token = append(token, c)
+	}
+
+	if p, ok := r.(interface{ Peek(n int) ([]byte, error) }); ok {
+		next, _ := p.Peek(2)
+		if len(next) < 2 || next[0] != ':' || !isDigit(next[1]) {
+			return Parse(string(token))
+		}
+	}
+
+	c, _, err := r.ReadRune()
+	switch {
+	case err == io.EOF:
+		return Parse(string(token))
+	case err != nil:
+		return DayTime{}, errors.Wrap(err, "read")
+	case c != ':':
+		_ = r.UnreadRune()
+
+		return Parse(string(token))
+	}
+
+	c, _, err = r.ReadRune()
+	switch {
+	case err == io.EOF:
+		return Parse(string(token))
+	case err != nil:
+		return DayTime{}, errors.Wrap(err, "read")
+	case c < '0' || c > '9':
+		_ = r.UnreadRune()
+
+		return Parse(string(token))
+	}
+
+	token = append(token, ':', byte(c))
+	c2, err := readTokenByte(r, true)
+	if err != nil {
+		return DayTime{}, errors.Wrap(err, fmt.Sprintf("token '%s'", token))
+	}
+
+	return Parse(string(append(token, c2)))
+}
+
+// readTokenByte reads a digit or the separator of a daytime token.
+func readTokenByte(r io.RuneScanner, digit bool) (byte, error) {
+	c, _, err := r.ReadRune()
+	if err == io.EOF {
+		return 0, errors.Wrap(ErrInvalid, "unexpected end")
+	}
+	if err != nil {
+		return 0, errors.Wrap(err, "read")
+	}
+
+	if (digit && (c < '0' || c > '9')) || (!digit && c != ':') {
+		_ = r.UnreadRune()
+
+		return 0, errors.Wrap(ErrInvalid, fmt.Sprintf("rune '%c'", c))
+	}
+
+	return byte(c), nil
+}
+
+// ParseCompact parse a daytime without separators in the HHMM or HHMMSS
+// form, e.g. 0900 or 090015.
+func ParseCompact(value string) (DayTime, error) {
+	value = strings.Trim(value, " \t")
+	if len(value) != 4 && len(value) != 6 {
+		return DayTime{}, errors.Wrap(ErrInvalid, fmt.Sprintf("value '%s'", value))
+	}
+
+	components := [3]int{}
+	for i := 0; i < len(value)/2; i++ {
+		component, ok := parseTwoDigits(value[i*2 : i*2+2])
+		if !ok {
+			return DayTime{}, errors.Wrap(ErrInvalid, fmt.Sprintf("value '%s'", value))
+		}
+		components[i] = component
+	}
+
+	return New(components[0], components[1], components[2])
+}
+
+// ParseAll parse the values by Parse, e.g. a column of a CSV file.
+// The results and the errors are parallel to the values, a failed value
+// gives the zero daytime and an error, a parsed one a nil error.
+// The errors are nil if all the values are parsed.
+func ParseAll(values []string) ([]DayTime, []error) {
+	daytimes := make([]DayTime, len(values))
+	var errs []error
+
+	for i, value := range values {
+		daytime, err := Parse(value)
+		if err != nil {
+			if errs == nil {
+				errs = make([]error, len(values))
+			}
+			errs[i] = errors.Wrap(err, fmt.Sprintf("value %d", i))
+
+			continue
+		}
+
+		daytimes[i] = daytime
+	}
+
+	return daytimes, errs
+}
+
+// ParseOffset parse a daytime from a Go duration since midnight,
+// e.g. 9h30m or 540m. The duration must be in the range [0, 24h).
+func ParseOffset(value string) (DayTime, error) {
+	d, err := time.ParseDuration(strings.Trim(value, " \t"))
+	if err != nil {
+		return DayTime{}, errors.Wrap(ErrInvalid, fmt.Sprintf("duration '%s'", value))
+	}
+
+	return FromDuration(d)
+}
+
+// ParseAny parse a daytime in any supported form, e.g. 09:00, 9:00, 0900
+// or 9:00 AM. Parse, ParseLenient, ParseCompact and Parse12 are tried in
+// order and the first success is returned.
+func ParseAny(value string) (DayTime, error) {
+	for _, parse := range []func(string) (DayTime, error){Parse, ParseLenient, ParseCompact, Parse12} {
+		if daytime, err := parse(value); err == nil {
+			return daytime, nil
+		}
+	}
+
+	return DayTime{}, errors.Wrap(ErrInvalid, fmt.Sprintf("value '%s' in any form", value))
+}
+
+// parseClock parses the HH:MM or HH:MM:SS[.fffffffff] form without
+// allocations. The ranges of the components are not checked.
+func parseClock(value string) (hour, minute, second, nanosecond int, ok bool) {
+	if len(value) != 5 && len(value) < 8 {
+		return 0, 0, 0, 0, false
+	}
+
+	hour, ok = parseTwoDigits(value[0:2])
+	if !ok || value[2] != ':' {
+		return 0, 0, 0, 0, false
+	}
+
+	minute, ok = parseTwoDigits(value[3:5])
+	if !ok {
+		return 0, 0, 0, 0, false
+	}
+
+	if len(value) == 5 {
+		return hour, minute, 0, 0, true
+	}
+
+	if value[5] != ':' {
+		return 0, 0, 0, 0, false
+	}
+
+	second, ok = parseTwoDigits(value[6:8])
+	if !ok {
+		return 0, 0, 0, 0, false
+	}
+
+	if len(value) == 8 {
+		return hour, minute, second, 0, true
+	}
+
+	nanosecond, ok = parseFraction(value[8:])
+	if !ok {
+		return 0, 0, 0, 0, false
+	}
+
+	return hour, minute, second, nanosecond, true
+}
+
+// parseFraction parses a dot or a comma followed by one to nine digits
+// as nanoseconds.
+func parseFraction(value string) (int, bool) {
+	if len(value) < 2 || len(value) > 10 || (value[0] != '.' && value[0] != ',') {
+		return 0, false
+	}
+
+	nanosecond := 0
+	for i := 1; i < 10; i++ {
+		nanosecond *= 10
+		if i >= len(value) {
+			continue
+		}
+		if !isDigit(value[i]) {
+			return 0, false
+		}
+		nanosecond += int(value[i] - '0')
+	}
+
+	return nanosecond, true
+}
+
+// parseLenientClock parses the H:M or H:M:S form with one or two digits
+// per component. The ranges of the components are not checked.
+func parseLenientClock(value string) (hour, minute, second int, ok bool) {
+	components := [3]int{}
+	count := 0
+	for {
+		if count == len(components) {
+			return 0, 0, 0, false
+		}
+
+		digits := 0
+		for digits < len(value) && isDigit(value[digits]) {
+			digits++
+		}
+		if digits == 0 || digits > 2 {
+			return 0, 0, 0, false
+		}
+
+		for _, c := range []byte(value[:digits]) {
+			components[count] = components[count]*10 + int(c-'0')
+		}
+		count++
+
+		value = value[digits:]
+		if value == "" {
+			break
+		}
+		if value[0] != ':' {
+			return 0, 0, 0, false
+		}
+		value = value[1:]
+	}
+
+	if count < 2 {
+		return 0, 0, 0, false
+	}
+
+	return components[0], components[1], components[2], true
+}
+
+// parseTwoDigits parses exactly two decimal digits.
+func parseTwoDigits(value string) (int, bool) {
+	if len(value) != 2 || !isDigit(value[0]) || !isDigit(value[1]) {
+		return 0, false
+	}
+
+	return int(value[0]-'0')*10 + int(value[1]-'0'), true
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+// FromTime create a daytime from the clock of the time.
+// The date and fractional seconds are discarded.
+func FromTime(t time.Time) DayTime {
+	hour, minute, second := t.Clock()
+
+	return DayTime{
+		hour:   hour,
+		minute: minute,
+		second: second,
+	}
+}
+
+// Now returns the current daytime in the local location.
+func Now() DayTime {
+	return FromTime(nowFunc())
+}
+
+// FromDuration create a daytime from the duration since midnight.
+// The fractional seconds are discarded.
+func FromDuration(d time.Duration) (DayTime, error) {
+	if d < 0 || d >= Day {
+		return DayTime{}, errors.Wrap(ErrInvalid, fmt.Sprintf("value of duration is %s", d))
+	}
+
+	return fromSeconds(int(d / time.Second)), nil
+}
+
+// NewFromComponents create a daytime from the duration since midnight,
+// the same as FromDuration.
+func NewFromComponents(d time.Duration) (DayTime, error) {
+	return FromDuration(d)
+}
+
+// NewFromClock create a daytime from the HH:MM or HH:MM:SS form,
+// the same as Parse.
+func NewFromClock(hhmmss string) (DayTime, error) {
+	return Parse(hhmmss)
+}
+
+// FromSeconds create a daytime from the number of seconds since midnight,
+// the counterpart of TotalSeconds.
+func FromSeconds(seconds int) (DayTime, error) {
+	if seconds < 0 || seconds >= secondsInDay {
+		return DayTime{}, errors.Wrap(ErrInvalid, fmt.Sprintf("value of seconds is %d", seconds))
+	}
+
+	return fromSeconds(seconds), nil
+}
+
+// FromProtoSeconds create a daytime from the int32 number of seconds since
+// midnight used in protobuf messages, the counterpart of ToProtoSeconds.
+// The value must be in the range 0..86399.
+func FromProtoSeconds(s int32) (DayTime, error) {
+	return FromSeconds(int(s))
+}
+
+// String convert to string. The seconds are included only when they are not
+// zero, the fractional part only when it is not zero.
+func (t *DayTime) String() string {
+	buf := [18]byte{}
+
+	return string(t.AppendFormat(buf[:0]))
+}
+
+// StringWithSeconds convert to string always in the HH:MM:SS form,
+// e.g. 00:00:00 for a nil daytime. The fractional part is included only
+// when it is not zero.
+func (t *DayTime) StringWithSeconds() string {
+	buf := [18]byte{}
+
+	return string(t.appendWithSeconds(buf[:0]))
+}
+
+// appendWithSeconds appends the HH:MM:SS form to b.
+func (t *DayTime) appendWithSeconds(b []byte) []byte {
+	hour, minute, second := t.Clock()
+
+	b = appendTwoDigits(b, hour)
+	b = append(b, ':')
+	b = appendTwoDigits(b, minute)
+	b = append(b, ':')
+	b = appendTwoDigits(b, second)
+	if t != nil && t.nanosecond != 0 {
+		b = appendFraction(b, t.nanosecond)
+	}
+
+	return b
+}
+
+// AppendFormat appends the string representation to b and returns the extended buffer.
+func (t *DayTime) AppendFormat(b []byte) []byte {
+	if t == nil {
+		return append(b, DefaultTime...)
+	}
+
+	return t.appendWithSeparator(b, ":")
+}
+
+// StringSep convert to string with the separator between the components,
+// e.g. 01.02.03 for a dot or 010203 for an empty separator. The seconds
+// and the fractional part are included as in String.
+func (t *DayTime) StringSep(sep string) string {
+	daytime := DayTime{}
+	if t != nil {
+		daytime = *t
+	}
+
+	return string(daytime.appendWithSeparator(make([]byte, 0, 16+2*len(sep)), sep))
+}
+
+// appendWithSeparator appends the components separated by sep to b.
+func (t *DayTime) appendWithSeparator(b []byte, sep string) []byte {
+	b = appendTwoDigits(b, t.hour)
+	b = append(b, sep...)
+	b = appendTwoDigits(b, t.minute)
+	if t.second != 0 || t.nanosecond != 0 {
+		b = append(b, sep...)
+		b = appendTwoDigits(b, t.second)
+	}
+	if t.nanosecond != 0 {
+		b = appendFraction(b, t.nanosecond)
+	}
+
+	return b
+}
+
+// appendFraction appends the nanoseconds as a fraction of a second with
+// three, six or nine digits, whichever is the shortest exact form.
+func appendFraction(b []byte, nanosecond int) []byte {
+	digits := 9
+	for digits > 3 && nanosecond%1000 == 0 {
+		nanosecond /= 1000
+		digits -= 3
+	}
+
+	b = append(b, '.')
+	for divisor := pow10(digits - 1); divisor > 0; divisor /= 10 {
+		b = append(b, byte('0'+nanosecond/divisor%10))
+	}
+
+	return b
+}
+
+func pow10(n int) int {
+	result := 1
+	for i := 0; i < n; i++ {
+		result *= 10
+	}
+
+	return result
+}
+
+// Format returns the daytime formatted according to the layout. The layout
+// uses the clock tokens of the reference time of the time package: 15, 03, 3,
+// 04, 4, 05, 5, PM and pm. Date tokens are not supported, any other text is
+// copied to the result unchanged.
+func (t *DayTime) Format(layout string) string {
+	hour, minute, second := t.Clock()
+	b := make([]byte, 0, len(layout)+4)
+	for i := 0; i < len(layout); {
+		token := layout[i:]
+		switch {
+		case strings.HasPrefix(token, "15"):
+			b = appendTwoDigits(b, hour)
+			i += 2
+		case strings.HasPrefix(token, "03"):
+			b = appendTwoDigits(b, hour12(hour))
+			i += 2
+		case strings.HasPrefix(token, "04"):
+			b = appendTwoDigits(b, minute)
+			i += 2
+		case strings.HasPrefix(token, "05"):
+			b = appendTwoDigits(b, second)
+			i += 2
+		case strings.HasPrefix(token, "PM"):
+			b = append(b, meridiem(hour)...)
+			i += 2
+		case strings.HasPrefix(token, "pm"):
+			b = append(b, strings.ToLower(meridiem(hour))...)
+			i += 2
+		case token[0] == '3':
+			b = strconv.AppendInt(b, int64(hour12(hour)), 10)
+			i++
+		case token[0] == '4':
+			b = strconv.AppendInt(b, int64(minute), 10)
+			i++
+		case token[0] == '5':
+			b = strconv.AppendInt(b, int64(second), 10)
+			i++
+		default:
+			b = append(b, token[0])
+			i++
+		}
+	}
+
+	return string(b)
+}
+
+// String12 convert to string in the 12-hour clock, e.g. 1:02 AM.
+// Seconds are included only when they are not zero.
+func (t *DayTime) String12() string {
+	if t != nil && t.second != 0 {
+		return t.Format("3:04:05 PM")
+	}
+
+	return t.Format("3:04 PM")
+}
+
+// hour12 converts the hour to the 12-hour clock.
+func hour12(hour int) int {
+	if hour%12 == 0 {
+		return 12
+	}
+
+	return hour % 12
+}
+
+// meridiem returns AM or PM for the hour. The end of the day 24:00 is
+// midnight, so it is AM.
+func meridiem(hour int) string {
+	if hour%24 < 12 {
+		return "AM"
+	}
+
+	return "PM"
+}
+
+// appendTwoDigits appends the value zero-padded to two digits.
+func appendTwoDigits(b []byte, value int) []byte {
+	return append(b, byte('0'+value/10), byte('0'+value%10))
+}
+
+// GoString implements fmt.GoStringer, the %#v form is a call of MustParse,
+// e.g. daytime.MustParse("01:02:03"), or of MustParseAllowEndOfDay for 24:00.
+func (t DayTime) GoString() string {
+	if t.isEndOfDay() {
+		return `daytime.MustParseAllowEndOfDay("` + t.String() + `")`
+	}
+
+	return `daytime.MustParse("` + t.String() + `")`
+}
+
+// Clock returns the hour, minute and second.
+func (t *DayTime) Clock() (hour, minute, second int) {
+	if t == nil {
+		return 0, 0, 0
+	}
+
+	return t.hour, t.minute, t.second
+}
+
+// Clone returns a pointer to a copy of the daytime, or nil if the daytime is nil.
+func (t *DayTime) Clone() *DayTime {
+	if t == nil {
+		return nil
+	}
+
+	daytime := *t
+
+	return &daytime
+}
+
+// IsZero reports whether the daytime is unset, i.e. nil or the zero value.
+// Use it to detect a missing value rather than a time of day.
+func (t *DayTime) IsZero() bool {
+	return t == nil || *t == DayTime{}
+}
+
+// IsMidnight reports whether the clock shows midnight, either 00:00:00 or the
+// end of the day 24:00. A nil daytime is treated as 00:00:00.
+// Use it for the time of day rather than a missing value.
+func (t *DayTime) IsMidnight() bool {
+	return t.Duration()%Day == 0
+}
+
+// HasSeconds reports whether the seconds are not zero, i.e. whether String
+// includes them. The fractional seconds are not taken into account.
+func (t *DayTime) HasSeconds() bool {
+	return t != nil && t.second != 0
+}
+
+// WithHour returns a copy of the daytime with the hour replaced.
+// The value is checked like in New.
+func (t DayTime) WithHour(hour int) (DayTime, error) {
+	return NewWithNanos(hour, t.minute, t.second, t.nanosecond)
+}
+
+// WithMinute returns a copy of the daytime with the minute replaced.
+// The value is checked like in New.
+func (t DayTime) WithMinute(minute int) (DayTime, error) {
+	return NewWithNanos(t.hour, minute, t.second, t.nanosecond)
+}
+
+// WithSecond returns a copy of the daytime with the second replaced.
+// The value is checked like in New.
+func (t DayTime) WithSecond(second int) (DayTime, error) {
+	return NewWithNanos(t.hour, t.minute, second, t.nanosecond)
+}
+
+// Before reports whether the daytime is before other.
+// A nil daytime is treated as 00:00:00.
+func (t *DayTime) Before(other *DayTime) bool {
+	return t.Duration() < other.Duration()
+}
+
+// After reports whether the daytime is after other.
+// A nil daytime is treated as 00:00:00.
+func (t *DayTime) After(other *DayTime) bool {
+	return t.Duration() > other.Duration()
+}
+
+// Equal reports whether the daytime and other are the same time of day.
+// A nil daytime is treated as 00:00:00, so two nils are equal and nil
+// equals an explicit 00:00:00.
+func (t *DayTime) Equal(other *DayTime) bool {
+	return t.Duration() == other.Duration()
+}
 
-	ErrObjIsNil   = errors.New("object is nil")
-	ErrInvalid    = errors.New("invalid")
-	ErrUnexpected = errors.New("unexpected")
-)
+// EqualClock reports whether the daytime and other have the same hour and
+// minute, the seconds are ignored. A nil daytime is treated as 00:00:00.
+func (t *DayTime) EqualClock(other *DayTime) bool {
+	hour, minute, _ := t.Clock()
+	otherHour, otherMinute, _ := other.Clock()
 
-type DayTime struct {
-	hour   int
-	minute int
-	second int
+	return hour == otherHour && minute == otherMinute
 }
 
-// New create a new daytime.
-func New(hour int, minute int, second int) (DayTime, error) {
-	if hour < 0 || hour > 23 {
-		return DayTime{}, errors.Wrap(ErrInvalid, fmt.Sprintf("value of hour is %d", hour))
+// Compare returns -1 if the daytime is before other, +1 if it is after
+// and 0 if they are equal. A nil daytime is treated as 00:00:00.
+func (t *DayTime) Compare(other *DayTime) int {
+	switch {
+	case t.Before(other):
+		return -1
+	case t.After(other):
+		return +1
 	}
-	if minute < 0 || minute > 59 {
-		return DayTime{}, errors.Wrap(ErrInvalid, fmt.Sprintf("value of minute is %d", minute))
+
+	return 0
+}
+
+// CompareTime compares the daytime with the clock of ts as in Compare.
+// The clock is taken in the location of ts as in FromTime.
+func (t *DayTime) CompareTime(ts time.Time) int {
+	clock := FromTime(ts)
+
+	return t.Compare(&clock)
+}
+
+// Between reports whether the daytime lies between start and end.
+// The inclusive flag controls whether the endpoints match.
+// If start is after end, the interval is treated as crossing midnight.
+func (t *DayTime) Between(start, end *DayTime, inclusive bool) bool {
+	if inclusive && (t.Equal(start) || t.Equal(end)) {
+		return true
 	}
-	if second < 0 || second > 59 {
-		return DayTime{}, errors.Wrap(ErrInvalid, fmt.Sprintf("value of second is %d", second))
+
+	if start.After(end) {
+		return t.After(start) || t.Before(end)
 	}
 
-	return DayTime{
-		hour:   hour,
-		minute: minute,
-		second: second,
-	}, nil
+	return t.After(start) && t.Before(end)
 }
 
-// Parse parse a daytime.
-func Parse(value string) (DayTime, error) {
-	value = strings.Trim(string(value), " \t")
-	submatches := daytimeRegex.FindAllStringSubmatch(value, -1)
+// IsDaytime reports whether the daytime is at or after the sunrise and before
+// the sunset. If the sunset is before the sunrise, the day is treated as
+// crossing midnight, e.g. during a polar summer. Equal bounds give no day.
+func (t *DayTime) IsDaytime(sunrise, sunset DayTime) bool {
+	value, start, end := t.Duration(), sunrise.Duration(), sunset.Duration()
 
-	if len(submatches) == 0 {
-		return DayTime{}, errors.Wrap(ErrInvalid, fmt.Sprintf("value '%s'", value))
+	if start > end {
+		return value >= start || value < end
 	}
 
-	values := strings.Split(value, ":")
-	hour, err := strconv.Atoi(values[0])
-	if err != nil {
-		return DayTime{}, errors.Wrap(err, "hour")
+	return value >= start && value < end
+}
+
+// IsBetweenHours reports whether the daytime is at or after the start hour
+// and before the end hour, e.g. 9 and 18 for working hours or 22 and 6 for
+// the night crossing midnight. The start hour must be in the range 0..23 and
+// the end hour in 0..24, otherwise no daytime matches.
+func (t *DayTime) IsBetweenHours(startHour, endHour int) bool {
+	if startHour < 0 || startHour > 23 || endHour < 0 || endHour > 24 {
+		return false
 	}
 
-	minute, err := strconv.Atoi(values[1])
-	if err != nil {
-		return DayTime{}, errors.Wrap(err, "minute")
+	return t.IsDaytime(DayTime{hour: startHour}, DayTime{hour: endHour})
+}
+
+// MatchesTime reports whether the clock of ts is within the tolerance of the
+// daytime, measuring around midnight, e.g. 23:59 matches 00:01 with 2m.
+// The clock is taken in the location of ts as in FromTime.
+func (t *DayTime) MatchesTime(ts time.Time, tolerance time.Duration) bool {
+	clock := FromTime(ts)
+
+	return t.WrapDistance(&clock) <= tolerance
+}
+
+// Clamp returns lower if the daytime is before lower, upper if it is after
+// upper and the daytime otherwise. If lower is after upper, the result is
+// lower for daytimes before lower and upper for the rest.
+// A nil daytime is treated as 00:00:00.
+func (t *DayTime) Clamp(lower, upper *DayTime) DayTime {
+	switch {
+	case t.Before(lower):
+		return fromDuration(lower.Duration())
+	case t.After(upper):
+		return fromDuration(upper.Duration())
 	}
 
-	second := 0
-	if len(values) > 2 {
-		second, err = strconv.Atoi(values[2])
+	return fromDuration(t.Duration())
+}
+
+// Min returns the earliest of the daytimes, the boolean is false
+// if no daytimes are given.
+func Min(times ...DayTime) (DayTime, bool) {
+	if len(times) == 0 {
+		return DayTime{}, false
 	}
-	if err != nil {
-		return DayTime{}, errors.Wrap(err, "second")
+
+	result := times[0]
+	for i := range times[1:] {
+		if times[i+1].Before(&result) {
+			result = times[i+1]
+		}
 	}
 
-	return New(hour, minute, second)
+	return result, true
 }
 
-// String convert to string.
-func (t *DayTime) String() string {
+// Max returns the latest of the daytimes, the boolean is false
+// if no daytimes are given.
+func Max(times ...DayTime) (DayTime, bool) {
+	if len(times) == 0 {
+		return DayTime{}, false
+	}
+
+	result := times[0]
+	for i := range times[1:] {
+		if times[i+1].After(&result) {
+			result = times[i+1]
+		}
+	}
+
+	return result, true
+}
+
+// DayTimes is a slice of daytimes sortable from the earliest to the latest.
+type DayTimes []DayTime
+
+func (s DayTimes) Len() int           { return len(s) }
+func (s DayTimes) Less(i, j int) bool { return s[i].Before(&s[j]) }
+func (s DayTimes) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+
+// Sort sorts the daytimes from the earliest to the latest.
+func (s DayTimes) Sort() {
+	sort.Sort(s)
+}
+
+// Add returns the daytime plus d. The result wraps around midnight in both
+// directions, so 23:30 plus one hour is 00:30 and the number of days crossed
+// is lost.
+func (t *DayTime) Add(d time.Duration) DayTime {
+	value, _ := t.AddWithOverflow(d)
+
+	return value
+}
+
+// AddWithOverflow returns the daytime plus d like Add and the signed number
+// of days the addition rolled over, e.g. 23:00 plus 2h is 01:00 and 1 day.
+func (t *DayTime) AddWithOverflow(d time.Duration) (DayTime, int) {
+	value := t.Duration() + d
+	days := int(value / Day)
+	value %= Day
+	if value < 0 {
+		value += Day
+		days--
+	}
+
+	return fromDuration(value), days
+}
+
+// Sub returns the signed duration t-other within the same day.
+// A nil daytime is treated as 00:00:00.
+func (t *DayTime) Sub(other *DayTime) time.Duration {
+	return t.Duration() - other.Duration()
+}
+
+// WrapDistance returns the shortest distance between the daytimes on a clock
+// face in either direction, e.g. 23:00 and 01:00 are 2h apart, not 22h.
+func (t *DayTime) WrapDistance(other *DayTime) time.Duration {
+	distance := t.Sub(other) % Day
+	if distance < 0 {
+		distance = -distance
+	}
+
+	return min(distance, Day-distance)
+}
+
+// Duration returns the time elapsed since midnight.
+func (t *DayTime) Duration() time.Duration {
 	if t == nil {
-		return DefaultTime
+		return 0
 	}
 
-	hour := strconv.Itoa(t.hour)
-	if len(hour) == 1 {
-		hour = "0" + hour
+	return time.Duration(t.hour)*time.Hour +
+		time.Duration(t.minute)*time.Minute +
+		time.Duration(t.second)*time.Second +
+		time.Duration(t.nanosecond)
+}
+
+// TotalSeconds returns the number of whole seconds since midnight.
+// A nil daytime returns 0.
+func (t *DayTime) TotalSeconds() int {
+	if t == nil {
+		return 0
 	}
 
-	minute := strconv.Itoa(t.minute)
-	if len(minute) == 1 {
-		minute = "0" + minute
+	return t.hour*3600 + t.minute*60 + t.second
+}
+
+// TotalMinutes returns the number of whole minutes since midnight.
+// A nil daytime returns 0.
+func (t *DayTime) TotalMinutes() int {
+	return t.TotalSeconds() / 60
+}
+
+// ToProtoSeconds returns the number of whole seconds since midnight as int32
+// for protobuf messages. A nil daytime returns 0.
+func (t *DayTime) ToProtoSeconds() int32 {
+	return int32(t.TotalSeconds())
+}
+
+// Truncate returns the daytime rounded down to a multiple of d since midnight,
+// e.g. 09:07 truncated to 15m is 09:00. If d does not divide the day evenly,
+// the multiples are still counted from midnight. If d <= 0, the daytime is
+// returned unchanged.
+func (t *DayTime) Truncate(d time.Duration) DayTime {
+	value := t.Duration()
+	if d <= 0 {
+		return fromDuration(value)
 	}
 
-	value := hour + ":" + minute
-	if t.second == 0 {
-		return value
+	return fromDuration(value - value%d)
+}
+
+// Round returns the daytime rounded to the nearest multiple of d since
+// midnight, halfway values are rounded up. The result wraps around midnight,
+// e.g. 23:59 rounded to 1h is 00:00. If d <= 0, the daytime is returned
+// unchanged.
+func (t *DayTime) Round(d time.Duration) DayTime {
+	value := t.Duration()
+	if d <= 0 {
+		return fromDuration(value)
 	}
 
-	second := strconv.Itoa(t.second)
-	if len(second) == 1 {
-		second = "0" + second
+	remainder := value % d
+	if remainder+remainder < d {
+		return fromDuration(value - remainder)
 	}
 
-	return value + ":" + second
+	return fromDuration((value + d - remainder) % Day)
+}
+
+// fromSeconds create a daytime from the number of seconds since midnight.
+// The value must be in the range of a day.
+func fromSeconds(seconds int) DayTime {
+	return fromDuration(time.Duration(seconds) * time.Second)
+}
+
+// fromDuration create a daytime from the duration since midnight.
+// The value must be in the range of a day.
+func fromDuration(d time.Duration) DayTime {
+	return DayTime{
+		hour:       int(d / time.Hour),
+		minute:     int(d % time.Hour / time.Minute),
+		second:     int(d % time.Minute / time.Second),
+		nanosecond: int(d % time.Second),
+	}
 }
 
 // Time bringing to the current day's time.
 func (t *DayTime) Time() time.Time {
-	now := time.Now()
-	year, month, day := now.Date()
+	return t.TimeInLocation(time.Local)
+}
+
+// TimeInLocation bringing to the current day's time in the location.
+func (t *DayTime) TimeInLocation(loc *time.Location) time.Time {
+	return t.TimeOn(nowFunc().In(loc))
+}
+
+// TimeUTC bringing to the current day's time in UTC.
+func (t *DayTime) TimeUTC() time.Time {
+	return t.TimeInLocation(time.UTC)
+}
+
+// TimeOn places the daytime on the calendar day and location of the date.
+func (t *DayTime) TimeOn(date time.Time) time.Time {
+	year, month, day := date.Date()
 	hour := 0
 	minute := 0
 	second := 0
+	nanosecond := 0
 	if t != nil {
 		hour = t.hour
 		minute = t.minute
 		second = t.second
+		nanosecond = t.nanosecond
 	}
 
 	return time.Date(
@@ -129,37 +1189,171 @@ func (t *DayTime) Time() time.Time {
 		hour,
 		minute,
 		second,
-		0,
-		now.Location(),
+		nanosecond,
+		date.Location(),
 	)
 }
 
-// InTheNearFuture bringing to the current day's time.
+// InTheNearFuture bringing to the near future.
 func (t *DayTime) InTheNearFuture() time.Time {
-	now := time.Now()
-	datetime := t.Time()
+	return t.InTheNearFutureFrom(nowFunc())
+}
+
+// InTheNearFutureFrom bringing to the near future relative to ref.
+func (t *DayTime) InTheNearFutureFrom(ref time.Time) time.Time {
+	datetime := t.TimeOn(ref)
 
-	if datetime.Before(now) {
+	if datetime.Before(ref) {
 		datetime = datetime.Add(Day)
 	}
 
 	return datetime
 }
 
-// InTheRecentPast bringing to the near future.
+// InTheRecentPast bringing to the recent past.
 func (t *DayTime) InTheRecentPast() time.Time {
-	now := time.Now()
-	datetime := t.Time()
+	return t.InTheRecentPastFrom(nowFunc())
+}
+
+// InTheRecentPastFrom bringing to the recent past relative to ref.
+func (t *DayTime) InTheRecentPastFrom(ref time.Time) time.Time {
+	datetime := t.TimeOn(ref)
 
-	if datetime.After(now) {
+	if datetime.After(ref) {
 		datetime = datetime.Add(-Day)
 	}
 
 	return datetime
 }
 
-func (t *DayTime) MarshalBinary() ([]byte, error) {
-	return []byte(t.String()), nil
+// DurationUntil returns the duration until the near future occurrence,
+// it is zero if the current clock equals the daytime.
+func (t *DayTime) DurationUntil() time.Duration {
+	now := nowFunc()
+
+	return t.InTheNearFutureFrom(now).Sub(now)
+}
+
+// DurationSince returns the duration since the recent past occurrence.
+func (t *DayTime) DurationSince() time.Duration {
+	now := nowFunc()
+
+	return now.Sub(t.InTheRecentPastFrom(now))
+}
+
+// Until returns the near future occurrence and the duration until it,
+// both computed from the same current time.
+func (t *DayTime) Until() (target time.Time, d time.Duration) {
+	now := nowFunc()
+	target = t.InTheNearFutureFrom(now)
+
+	return target, target.Sub(now)
+}
+
+// TimerUntil returns a timer that fires once at the near future occurrence.
+// The caller owns the timer and is responsible for stopping it.
+func (t *DayTime) TimerUntil() *time.Timer {
+	return time.NewTimer(t.DurationUntil())
+}
+
+// WaitUntil blocks until the near future occurrence of the daytime.
+// It returns nil when the daytime is reached or the error of the context
+// if the context is done first.
+func (t *DayTime) WaitUntil(ctx context.Context) error {
+	timer := time.NewTimer(t.DurationUntil())
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// Humanize describes the nearest occurrence relative to the current time,
+// e.g. "in 2h 5m" or "3m ago". The duration is truncated to minutes and
+// "now" is returned for less than a minute.
+func (t *DayTime) Humanize() string {
+	now := nowFunc()
+	until := t.InTheNearFutureFrom(now).Sub(now).Truncate(time.Minute)
+	since := now.Sub(t.InTheRecentPastFrom(now)).Truncate(time.Minute)
+
+	switch {
+	case until == 0 || since == 0:
+		return "now"
+	case until <= since:
+		return "in " + humanizeDuration(until)
+	}
+
+	return humanizeDuration(since) + " ago"
+}
+
+// humanizeDuration formats a positive duration in hours and minutes, e.g. 2h 5m.
+func humanizeDuration(d time.Duration) string {
+	hours, minutes := int(d/time.Hour), int(d%time.Hour/time.Minute)
+
+	switch {
+	case hours == 0:
+		return strconv.Itoa(minutes) + "m"
+	case minutes == 0:
+		return strconv.Itoa(hours) + "h"
+	}
+
+	return strconv.Itoa(hours) + "h " + strconv.Itoa(minutes) + "m"
+}
+
+// NextOccurrence returns the soonest time strictly after the given time
+// whose clock equals the daytime. The next day is a calendar day, so the
+// clock is kept across daylight saving time transitions.
+func (t *DayTime) NextOccurrence(after time.Time) time.Time {
+	datetime := t.TimeOn(after)
+
+	if !datetime.After(after) {
+		datetime = t.TimeOn(after.AddDate(0, 0, 1))
+	}
+
+	return datetime
+}
+
+// PreviousOccurrence returns the latest time strictly before the given time
+// whose clock equals the daytime. The previous day is a calendar day, so the
+// clock is kept across daylight saving time transitions.
+func (t *DayTime) PreviousOccurrence(before time.Time) time.Time {
+	datetime := t.TimeOn(before)
+
+	if !datetime.Before(before) {
+		datetime = t.TimeOn(before.AddDate(0, 0, -1))
+	}
+
+	return datetime
+}
+
+// MarshalBinary encodes the daytime in 8 bytes: the version, the hour,
+// the minute, the second and the nanoseconds as a big-endian uint32.
+func (t DayTime) MarshalBinary() ([]byte, error) {
+	return t.AppendBinary(make([]byte, 0, binaryLength))
+}
+
+// AppendBinary implements encoding.BinaryAppender, it appends the binary
+// form of MarshalBinary to b.
+func (t *DayTime) AppendBinary(b []byte) ([]byte, error) {
+	hour, minute, second := t.Clock()
+	nanosecond := 0
+	if t != nil {
+		nanosecond = t.nanosecond
+	}
+
+	return append(b,
+		binaryVersion,
+		byte(hour),
+		byte(minute),
+		byte(second),
+		byte(nanosecond>>24),
+		byte(nanosecond>>16),
+		byte(nanosecond>>8),
+		byte(nanosecond),
+	), nil
 }
 
 func (t *DayTime) UnmarshalBinary(data []byte) error {
@@ -167,9 +1361,21 @@ func (t *DayTime) UnmarshalBinary(data []byte) error {
 		return ErrObjIsNil
 	}
 
-	value, err := Parse(string(data))
-	if err != nil {
-		return errors.Wrap(err, "parse")
+	if len(data) != binaryLength {
+		return errors.Wrap(ErrInvalid, fmt.Sprintf("length of binary is %d", len(data)))
+	}
+	if data[0] != binaryVersion {
+		return errors.Wrap(ErrInvalid, fmt.Sprintf("version of binary is %d", data[0]))
+	}
+
+	value := DayTime{
+		hour:       int(data[1]),
+		minute:     int(data[2]),
+		second:     int(data[3]),
+		nanosecond: int(data[4])<<24 | int(data[5])<<16 | int(data[6])<<8 | int(data[7]),
+	}
+	if err := value.Validate(); err != nil {
+		return errors.Wrap(err, "binary")
 	}
 
 	*t = value
@@ -177,8 +1383,14 @@ func (t *DayTime) UnmarshalBinary(data []byte) error {
 	return nil
 }
 
-func (t *DayTime) MarshalText() ([]byte, error) {
-	return []byte(t.String()), nil
+func (t DayTime) MarshalText() ([]byte, error) {
+	return t.AppendText(make([]byte, 0, 8))
+}
+
+// AppendText implements encoding.TextAppender, it appends the string
+// representation to b.
+func (t *DayTime) AppendText(b []byte) ([]byte, error) {
+	return t.AppendFormat(b), nil
 }
 
 func (t *DayTime) UnmarshalText(data []byte) error {
@@ -196,8 +1408,12 @@ func (t *DayTime) UnmarshalText(data []byte) error {
 	return nil
 }
 
-func (t *DayTime) MarshalJSON() ([]byte, error) {
-	return []byte(t.String()), nil
+func (t DayTime) MarshalJSON() ([]byte, error) {
+	b := make([]byte, 0, 10)
+	b = append(b, '"')
+	b = t.AppendFormat(b)
+
+	return append(b, '"'), nil
 }
 
 func (t *DayTime) UnmarshalJSON(data []byte) error {
@@ -205,7 +1421,16 @@ func (t *DayTime) UnmarshalJSON(data []byte) error {
 		return ErrObjIsNil
 	}
 
-	value, err := Parse(string(data))
+	str := string(data)
+	if str == "null" {
+		return nil
+	}
+
+	if len(str) < 2 || str[0] != '"' || str[len(str)-1] != '"' {
+		return errors.Wrap(ErrInvalid, fmt.Sprintf("json token '%s'", str))
+	}
+
+	value, err := Parse(str[1 : len(str)-1])
 	if err != nil {
 		return errors.Wrap(err, "parse")
 	}
@@ -215,7 +1440,40 @@ func (t *DayTime) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
-func (t *DayTime) MarshalCSV() (string, error) {
+// MarshalJSONNumber encodes the daytime as a JSON number of whole seconds
+// since midnight, e.g. 3723 for 01:02:03. A nil daytime is encoded as 0.
+func (t *DayTime) MarshalJSONNumber() ([]byte, error) {
+	return strconv.AppendInt(nil, int64(t.TotalSeconds()), 10), nil
+}
+
+// UnmarshalJSONNumber decodes a JSON number of seconds since midnight,
+// the counterpart of MarshalJSONNumber. A null leaves the daytime unchanged.
+func (t *DayTime) UnmarshalJSONNumber(data []byte) error {
+	if t == nil {
+		return ErrObjIsNil
+	}
+
+	str := string(data)
+	if str == "null" {
+		return nil
+	}
+
+	seconds, err := strconv.Atoi(str)
+	if err != nil {
+		return errors.Wrap(ErrInvalid, fmt.Sprintf("json number '%s'", str))
+	}
+
+	value, err := FromSeconds(seconds)
+	if err != nil {
+		return err
+	}
+
+	*t = value
+
+	return nil
+}
+
+func (t DayTime) MarshalCSV() (string, error) {
 	return t.String(), nil
 }
 
@@ -234,6 +1492,120 @@ func (t *DayTime) UnmarshalCSV(str string) error {
 	return nil
 }
 
+func (t DayTime) GobEncode() ([]byte, error) {
+	return t.MarshalBinary()
+}
+
+func (t *DayTime) GobDecode(data []byte) error {
+	return t.UnmarshalBinary(data)
+}
+
+// Set implements flag.Value, the value is parsed by Parse.
+func (t *DayTime) Set(value string) error {
+	if t == nil {
+		return ErrObjIsNil
+	}
+
+	daytime, err := Parse(value)
+	if err != nil {
+		return errors.Wrap(err, "parse")
+	}
+
+	*t = daytime
+
+	return nil
+}
+
+// Type returns the name of the flag value type, so *DayTime also implements
+// pflag.Value of github.com/spf13/pflag and can be used by FlagSet.VarP.
+func (t *DayTime) Type() string {
+	return "daytime"
+}
+
+// Flag defines a daytime flag with the name, default value and usage,
+// like flag.Duration. The returned value is set when the flags are parsed.
+func Flag(name string, value DayTime, usage string) *DayTime {
+	daytime := value
+	flag.Var(&daytime, name, usage)
+
+	return &daytime
+}
+
+// LogValue implements slog.LogValuer, the daytime is logged as a string.
+func (t DayTime) LogValue() slog.Value {
+	return slog.StringValue(t.String())
+}
+
+func (t DayTime) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return e.EncodeElement(t.String(), start)
+}
+
+func (t *DayTime) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	if t == nil {
+		return ErrObjIsNil
+	}
+
+	str := ""
+	if err := d.DecodeElement(&str, &start); err != nil {
+		return errors.Wrap(err, "decode")
+	}
+
+	value, err := Parse(str)
+	if err != nil {
+		return errors.Wrap(err, "parse")
+	}
+
+	*t = value
+
+	return nil
+}
+
+func (t DayTime) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return xml.Attr{Name: name, Value: t.String()}, nil
+}
+
+func (t *DayTime) UnmarshalXMLAttr(attr xml.Attr) error {
+	if t == nil {
+		return ErrObjIsNil
+	}
+
+	value, err := Parse(attr.Value)
+	if err != nil {
+		return errors.Wrap(err, "parse")
+	}
+
+	*t = value
+
+	return nil
+}
+
+func (t DayTime) MarshalYAML() (interface{}, error) {
+	return t.String(), nil
+}
+
+func (t *DayTime) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if t == nil {
+		return ErrObjIsNil
+	}
+
+	str := ""
+	if err := unmarshal(&str); err != nil {
+		return errors.Wrap(err, "unmarshal")
+	}
+
+	value, err := Parse(str)
+	if err != nil {
+		return errors.Wrap(err, "parse")
+	}
+
+	*t = value
+
+	return nil
+}
+
+// Scan implements sql.Scanner. NULL is scanned as 00:00:00,
+// use NullDayTime to distinguish NULL from midnight. An integer is scanned
+// as the number of seconds since midnight as in FromSeconds.
 func (t *DayTime) Scan(src any) error {
 	if t == nil {
 		return ErrObjIsNil
@@ -241,11 +1613,22 @@ func (t *DayTime) Scan(src any) error {
 
 	str := ""
 	switch src := src.(type) {
+	case nil:
+		*t = DayTime{}
+
+		return nil
 	case []byte:
 		str = string(src)
-		fmt.Printf("str(bytes) = '%s'\n", str)
 	case string:
 		str = src
+	case time.Time:
+		*t = FromTime(src)
+
+		return nil
+	case int64:
+		return t.scanSeconds(src)
+	case int:
+		return t.scanSeconds(int64(src))
 	default:
 		return errors.Wrap(ErrUnexpected, fmt.Sprintf("type of value '%T'", src))
 	}
@@ -260,6 +1643,36 @@ func (t *DayTime) Scan(src any) error {
 	return nil
 }
 
+// scanSeconds sets the daytime from the number of seconds since midnight.
+func (t *DayTime) scanSeconds(seconds int64) error {
+	if seconds < 0 || seconds >= int64(secondsInDay) {
+		return errors.Wrap(ErrInvalid, fmt.Sprintf("value of seconds is %d", seconds))
+	}
+
+	*t = fromSeconds(int(seconds))
+
+	return nil
+}
+
+// Value implements driver.Valuer. The value is always in the HH:MM:SS form
+// expected by TIME columns, a nil or zero daytime is written as 00:00:00,
+// use ValueOrNull or NullDayTime to write NULL instead.
 func (t *DayTime) Value() (driver.Value, error) {
-	return t.String(), nil
+	return t.StringWithSeconds(), nil
+}
+
+// ValueSeconds is like Value but for integer columns, the value is always
+// the int64 number of whole seconds since midnight as in TotalSeconds,
+// a nil or zero daytime is written as 0.
+func (t *DayTime) ValueSeconds() (driver.Value, error) {
+	return int64(t.TotalSeconds()), nil
+}
+
+// ValueOrNull is like Value but returns NULL for a nil or zero daytime.
+func (t *DayTime) ValueOrNull() (driver.Value, error) {
+	if t == nil || *t == (DayTime{}) {
+		return nil, nil
+	}
+
+	return t.Value()
 }
@@ -1,11 +1,26 @@
 package daytime
 
 import (
+	"bufio"
+	"bytes"
+	"context"
 	"database/sql/driver"
+	"encoding"
+	"encoding/gob"
+	"encoding/json"
+	"encoding/xml"
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"strings"
 	"testing"
 	"time"
+	_ "time/tzdata"
 
 	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
 )
 
 func TestNew(t *testing.T) {
@@ -90,6 +105,236 @@ func TestNew(t *testing.T) {
 	}
 }
 
+func TestNormalize(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		hour   int
+		minute int
+		second int
+	}
+	tests := []struct {
+		name           string
+		args           args
+		expectedResult DayTime
+	}{
+		{
+			name: "Checking standard work",
+			args: args{
+				hour:   1,
+				minute: 2,
+				second: 3,
+			},
+			expectedResult: DayTime{hour: 1, minute: 2, second: 3},
+		},
+		{
+			name: "Checking the processing of overflowing components",
+			args: args{
+				hour:   25,
+				minute: 70,
+				second: 90,
+			},
+			expectedResult: DayTime{hour: 2, minute: 11, second: 30},
+		},
+		{
+			name: "Checking the processing of a negative hour",
+			args: args{
+				hour:   -1,
+				minute: 0,
+				second: 0,
+			},
+			expectedResult: DayTime{hour: 23},
+		},
+		{
+			name: "Checking the processing of a negative second",
+			args: args{
+				hour:   0,
+				minute: 0,
+				second: -1,
+			},
+			expectedResult: DayTime{hour: 23, minute: 59, second: 59},
+		},
+		{
+			name: "Checking the processing of a whole day",
+			args: args{
+				hour:   24,
+				minute: 0,
+				second: 0,
+			},
+			expectedResult: DayTime{},
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+
+			daytime := Normalize(test.args.hour, test.args.minute, test.args.second)
+			assert.EqualValues(tt, test.expectedResult, daytime)
+		})
+	}
+}
+
+func TestNewWithNanos(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		hour       int
+		minute     int
+		second     int
+		nanosecond int
+	}
+	type expectedResult struct {
+		daytime DayTime
+		err     error
+	}
+	tests := []struct {
+		name           string
+		args           args
+		expectedResult expectedResult
+	}{
+		{
+			name: "Checking standard work",
+			args: args{
+				hour:       1,
+				minute:     2,
+				second:     3,
+				nanosecond: 250000000,
+			},
+			expectedResult: expectedResult{
+				daytime: DayTime{
+					hour:       1,
+					minute:     2,
+					second:     3,
+					nanosecond: 250000000,
+				},
+				err: nil,
+			},
+		},
+		{
+			name: "Checking the processing of an invalid nanosecond value",
+			args: args{
+				hour:       1,
+				minute:     2,
+				second:     3,
+				nanosecond: 1000000000,
+			},
+			expectedResult: expectedResult{
+				daytime: DayTime{},
+				err:     ErrInvalid,
+			},
+		},
+		{
+			name: "Checking the processing of an invalid hour value",
+			args: args{
+				hour:       24,
+				minute:     2,
+				second:     3,
+				nanosecond: 4,
+			},
+			expectedResult: expectedResult{
+				daytime: DayTime{},
+				err:     ErrInvalid,
+			},
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+
+			daytime, err := NewWithNanos(test.args.hour, test.args.minute, test.args.second, test.args.nanosecond)
+			assert.EqualValues(tt, test.expectedResult.daytime, daytime)
+			assert.ErrorIs(tt, err, test.expectedResult.err)
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		daytime        *DayTime
+		expectedResult error
+	}{
+		{
+			name: "Checking standard work",
+			daytime: &DayTime{
+				hour:       23,
+				minute:     59,
+				second:     59,
+				nanosecond: 999999999,
+			},
+			expectedResult: nil,
+		},
+		{
+			name:           "Checking the processing of the end of the day",
+			daytime:        &DayTime{hour: 24},
+			expectedResult: nil,
+		},
+		{
+			name:           "Checking the processing of a large hour",
+			daytime:        &DayTime{hour: 25},
+			expectedResult: ErrInvalid,
+		},
+		{
+			name:           "Checking the processing of a value after the end of the day",
+			daytime:        &DayTime{hour: 24, second: 1},
+			expectedResult: ErrInvalid,
+		},
+		{
+			name:           "Checking the processing of a negative hour",
+			daytime:        &DayTime{hour: -1},
+			expectedResult: ErrInvalid,
+		},
+		{
+			name:           "Checking the processing of a large minute",
+			daytime:        &DayTime{minute: 60},
+			expectedResult: ErrInvalid,
+		},
+		{
+			name:           "Checking the processing of a negative minute",
+			daytime:        &DayTime{minute: -1},
+			expectedResult: ErrInvalid,
+		},
+		{
+			name:           "Checking the processing of a large second",
+			daytime:        &DayTime{second: 60},
+			expectedResult: ErrInvalid,
+		},
+		{
+			name:           "Checking the processing of a negative second",
+			daytime:        &DayTime{second: -1},
+			expectedResult: ErrInvalid,
+		},
+		{
+			name:           "Checking the processing of a large nanosecond",
+			daytime:        &DayTime{nanosecond: 1000000000},
+			expectedResult: ErrInvalid,
+		},
+		{
+			name:           "Checking the processing of a negative nanosecond",
+			daytime:        &DayTime{nanosecond: -1},
+			expectedResult: ErrInvalid,
+		},
+		{
+			name:           "Checking to process nil",
+			daytime:        nil,
+			expectedResult: nil,
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+
+			err := test.daytime.Validate()
+			assert.ErrorIs(tt, err, test.expectedResult)
+		})
+	}
+}
+
 func TestParse(t *testing.T) {
 	t.Parallel()
 
@@ -111,67 +356,4782 @@ func TestParse(t *testing.T) {
 				value: "00:01:02",
 			},
 			expectedResult: expectedResult{
-				daytime: DayTime{
-					hour:   0,
-					minute: 1,
-					second: 2,
+				daytime: DayTime{
+					hour:   0,
+					minute: 1,
+					second: 2,
+				},
+				err: nil,
+			},
+		},
+		{
+			name: "Checking the value without second",
+			args: args{
+				value: " 23:59\t",
+			},
+			expectedResult: expectedResult{
+				daytime: DayTime{
+					hour:   23,
+					minute: 59,
+				},
+				err: nil,
+			},
+		},
+		{
+			name: "Checking the processing of an invalid value",
+			args: args{
+				value: "",
+			},
+			expectedResult: expectedResult{
+				daytime: DayTime{},
+				err:     ErrInvalid,
+			},
+		},
+		{
+			name: "Checking the value with milliseconds",
+			args: args{
+				value: "01:02:03.250",
+			},
+			expectedResult: expectedResult{
+				daytime: DayTime{
+					hour:       1,
+					minute:     2,
+					second:     3,
+					nanosecond: 250000000,
+				},
+				err: nil,
+			},
+		},
+		{
+			name: "Checking the value with a decimal comma",
+			args: args{
+				value: "01:02:03,250",
+			},
+			expectedResult: expectedResult{
+				daytime: DayTime{
+					hour:       1,
+					minute:     2,
+					second:     3,
+					nanosecond: 250000000,
+				},
+				err: nil,
+			},
+		},
+		{
+			name: "Checking the value with microseconds",
+			args: args{
+				value: "01:02:03.123456",
+			},
+			expectedResult: expectedResult{
+				daytime: DayTime{
+					hour:       1,
+					minute:     2,
+					second:     3,
+					nanosecond: 123456000,
+				},
+				err: nil,
+			},
+		},
+		{
+			name: "Checking the processing of a fraction without second",
+			args: args{
+				value: "01:02.250",
+			},
+			expectedResult: expectedResult{
+				daytime: DayTime{},
+				err:     ErrInvalid,
+			},
+		},
+		{
+			name: "Checking the processing of an empty fraction",
+			args: args{
+				value: "01:02:03.",
+			},
+			expectedResult: expectedResult{
+				daytime: DayTime{},
+				err:     ErrInvalid,
+			},
+		},
+		{
+			name: "Checking the processing of a too long fraction",
+			args: args{
+				value: "01:02:03.1234567890",
+			},
+			expectedResult: expectedResult{
+				daytime: DayTime{},
+				err:     ErrInvalid,
+			},
+		},
+		{
+			name: "Checking the processing of a single digit hour",
+			args: args{
+				value: "1:02:03",
+			},
+			expectedResult: expectedResult{
+				daytime: DayTime{},
+				err:     ErrInvalid,
+			},
+		},
+		{
+			name: "Checking the processing of an invalid separator",
+			args: args{
+				value: "01:02-03",
+			},
+			expectedResult: expectedResult{
+				daytime: DayTime{},
+				err:     ErrInvalid,
+			},
+		},
+		{
+			name: "Checking the processing of non-digit characters",
+			args: args{
+				value: "0a:02",
+			},
+			expectedResult: expectedResult{
+				daytime: DayTime{},
+				err:     ErrInvalid,
+			},
+		},
+		{
+			name: "Checking the processing of an out of range value",
+			args: args{
+				value: "24:00",
+			},
+			expectedResult: expectedResult{
+				daytime: DayTime{},
+				err:     ErrInvalid,
+			},
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+
+			daytime, err := Parse(test.args.value)
+			assert.EqualValues(tt, test.expectedResult.daytime, daytime)
+			assert.ErrorIs(tt, err, test.expectedResult.err)
+		})
+	}
+}
+
+func TestIsValidString(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		value          string
+		expectedResult bool
+	}{
+		{
+			name:           "Checking standard work",
+			value:          "09:30",
+			expectedResult: true,
+		},
+		{
+			name:           "Checking the value with fractional seconds",
+			value:          " 23:59:59.999 ",
+			expectedResult: true,
+		},
+		{
+			name:           "Checking the processing of an empty value",
+			value:          "",
+			expectedResult: false,
+		},
+		{
+			name:           "Checking the processing of an out of range hour",
+			value:          "24:00",
+			expectedResult: false,
+		},
+		{
+			name:           "Checking the processing of an out of range second",
+			value:          "09:30:60",
+			expectedResult: false,
+		},
+		{
+			name:           "Checking the processing of a single digit hour",
+			value:          "9:30",
+			expectedResult: false,
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+
+			_, err := Parse(test.value)
+			assert.EqualValues(tt, test.expectedResult, IsValidString(test.value))
+			assert.EqualValues(tt, err == nil, IsValidString(test.value))
+		})
+	}
+}
+
+func TestEndOfDayRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	endOfDay := DayTime{hour: 24}
+
+	text, err := endOfDay.MarshalText()
+	assert.NoError(t, err)
+	assert.Equal(t, "24:00", string(text))
+	fromText := DayTime{}
+	assert.ErrorIs(t, fromText.UnmarshalText(text), ErrInvalid)
+	fromText, err = ParseAllowEndOfDay(string(text))
+	assert.NoError(t, err)
+	assert.EqualValues(t, endOfDay, fromText)
+
+	data, err := json.Marshal(endOfDay)
+	assert.NoError(t, err)
+	assert.Equal(t, `"24:00"`, string(data))
+	fromJSON := DayTime{}
+	assert.ErrorIs(t, json.Unmarshal(data, &fromJSON), ErrInvalid)
+
+	csv, err := endOfDay.MarshalCSV()
+	assert.NoError(t, err)
+	fromCSV := DayTime{}
+	assert.ErrorIs(t, fromCSV.UnmarshalCSV(csv), ErrInvalid)
+
+	type schedule struct {
+		Start DayTime `xml:"start"`
+		End   DayTime `xml:"end,attr"`
+	}
+	xmlData, err := xml.Marshal(schedule{Start: endOfDay, End: endOfDay})
+	assert.NoError(t, err)
+	fromXML := schedule{}
+	assert.ErrorIs(t, xml.Unmarshal(xmlData, &fromXML), ErrInvalid)
+
+	yamlData, err := yaml.Marshal(endOfDay)
+	assert.NoError(t, err)
+	fromYAML := DayTime{}
+	assert.ErrorIs(t, yaml.Unmarshal(yamlData, &fromYAML), ErrInvalid)
+
+	value, err := endOfDay.Value()
+	assert.NoError(t, err)
+	fromValue := DayTime{}
+	assert.ErrorIs(t, fromValue.Scan(value), ErrInvalid)
+
+	seconds, err := endOfDay.ValueSeconds()
+	assert.NoError(t, err)
+	fromSeconds := DayTime{}
+	assert.ErrorIs(t, fromSeconds.Scan(seconds), ErrInvalid)
+
+	number, err := endOfDay.MarshalJSONNumber()
+	assert.NoError(t, err)
+	fromNumber := DayTime{}
+	assert.ErrorIs(t, fromNumber.UnmarshalJSONNumber(number), ErrInvalid)
+
+	_, err = FromProtoSeconds(endOfDay.ToProtoSeconds())
+	assert.ErrorIs(t, err, ErrInvalid)
+
+	assert.EqualValues(t, endOfDay, MustParseAllowEndOfDay("24:00"))
+	assert.Panics(t, func() { MustParseAllowEndOfDay("24:01") })
+}
+
+func TestParseStrict(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		value          string
+		requireSeconds bool
+	}
+	type expectedResult struct {
+		daytime DayTime
+		err     error
+	}
+	tests := []struct {
+		name           string
+		args           args
+		expectedResult expectedResult
+	}{
+		{
+			name: "Checking standard work",
+			args: args{
+				value:          "09:00:15",
+				requireSeconds: true,
+			},
+			expectedResult: expectedResult{
+				daytime: DayTime{hour: 9, second: 15},
+				err:     nil,
+			},
+		},
+		{
+			name: "Checking the processing of a value without required seconds",
+			args: args{
+				value:          "09:00",
+				requireSeconds: true,
+			},
+			expectedResult: expectedResult{
+				daytime: DayTime{},
+				err:     ErrInvalid,
+			},
+		},
+		{
+			name: "Checking the value without seconds",
+			args: args{
+				value:          "09:00",
+				requireSeconds: false,
+			},
+			expectedResult: expectedResult{
+				daytime: DayTime{hour: 9},
+				err:     nil,
+			},
+		},
+		{
+			name: "Checking the processing of unexpected seconds",
+			args: args{
+				value:          "09:00:00",
+				requireSeconds: false,
+			},
+			expectedResult: expectedResult{
+				daytime: DayTime{},
+				err:     ErrInvalid,
+			},
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+
+			daytime, err := ParseStrict(test.args.value, test.args.requireSeconds)
+			assert.EqualValues(tt, test.expectedResult.daytime, daytime)
+			assert.ErrorIs(tt, err, test.expectedResult.err)
+		})
+	}
+}
+
+func TestParseAny(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		value string
+	}
+	type expectedResult struct {
+		daytime DayTime
+		err     error
+	}
+	tests := []struct {
+		name           string
+		args           args
+		expectedResult expectedResult
+	}{
+		{
+			name: "Checking standard work",
+			args: args{
+				value: "09:00:15.250",
+			},
+			expectedResult: expectedResult{
+				daytime: DayTime{hour: 9, second: 15, nanosecond: 250000000},
+				err:     nil,
+			},
+		},
+		{
+			name: "Checking the lenient form",
+			args: args{
+				value: "9:00",
+			},
+			expectedResult: expectedResult{
+				daytime: DayTime{hour: 9},
+				err:     nil,
+			},
+		},
+		{
+			name: "Checking the compact form",
+			args: args{
+				value: "0930",
+			},
+			expectedResult: expectedResult{
+				daytime: DayTime{hour: 9, minute: 30},
+				err:     nil,
+			},
+		},
+		{
+			name: "Checking the 12-hour form",
+			args: args{
+				value: "9:00 PM",
+			},
+			expectedResult: expectedResult{
+				daytime: DayTime{hour: 21},
+				err:     nil,
+			},
+		},
+		{
+			name: "Checking the processing of an invalid value",
+			args: args{
+				value: "nine o'clock",
+			},
+			expectedResult: expectedResult{
+				daytime: DayTime{},
+				err:     ErrInvalid,
+			},
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+
+			daytime, err := ParseAny(test.args.value)
+			assert.EqualValues(tt, test.expectedResult.daytime, daytime)
+			assert.ErrorIs(tt, err, test.expectedResult.err)
+		})
+	}
+}
+
+func TestParseAll(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		values []string
+	}
+	type expectedResult struct {
+		daytimes []DayTime
+		errs     []error
+	}
+	tests := []struct {
+		name           string
+		args           args
+		expectedResult expectedResult
+	}{
+		{
+			name: "Checking standard work",
+			args: args{
+				values: []string{"09:00", "17:30:15"},
+			},
+			expectedResult: expectedResult{
+				daytimes: []DayTime{{hour: 9}, {hour: 17, minute: 30, second: 15}},
+				errs:     nil,
+			},
+		},
+		{
+			name: "Checking the processing of invalid values",
+			args: args{
+				values: []string{"09:00", "25:00", "17:30", ""},
+			},
+			expectedResult: expectedResult{
+				daytimes: []DayTime{{hour: 9}, {}, {hour: 17, minute: 30}, {}},
+				errs:     []error{nil, ErrInvalid, nil, ErrInvalid},
+			},
+		},
+		{
+			name: "Checking to process nil",
+			args: args{
+				values: nil,
+			},
+			expectedResult: expectedResult{
+				daytimes: []DayTime{},
+				errs:     nil,
+			},
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+
+			daytimes, errs := ParseAll(test.args.values)
+			assert.EqualValues(tt, test.expectedResult.daytimes, daytimes)
+			assert.Len(tt, errs, len(test.expectedResult.errs))
+			for i, err := range errs {
+				assert.ErrorIs(tt, err, test.expectedResult.errs[i])
+			}
+		})
+	}
+}
+
+func TestParseOffset(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		value string
+	}
+	type expectedResult struct {
+		daytime DayTime
+		err     error
+	}
+	tests := []struct {
+		name           string
+		args           args
+		expectedResult expectedResult
+	}{
+		{
+			name: "Checking standard work",
+			args: args{
+				value: "9h",
+			},
+			expectedResult: expectedResult{
+				daytime: DayTime{hour: 9},
+				err:     nil,
+			},
+		},
+		{
+			name: "Checking the value with all components",
+			args: args{
+				value: "9h30m15s",
+			},
+			expectedResult: expectedResult{
+				daytime: DayTime{hour: 9, minute: 30, second: 15},
+				err:     nil,
+			},
+		},
+		{
+			name: "Checking the value in minutes",
+			args: args{
+				value: "540m",
+			},
+			expectedResult: expectedResult{
+				daytime: DayTime{hour: 9},
+				err:     nil,
+			},
+		},
+		{
+			name: "Checking the processing of a value after the end of the day",
+			args: args{
+				value: "25h",
+			},
+			expectedResult: expectedResult{
+				daytime: DayTime{},
+				err:     ErrInvalid,
+			},
+		},
+		{
+			name: "Checking the processing of a negative value",
+			args: args{
+				value: "-1h",
+			},
+			expectedResult: expectedResult{
+				daytime: DayTime{},
+				err:     ErrInvalid,
+			},
+		},
+		{
+			name: "Checking the processing of an invalid value",
+			args: args{
+				value: "09:00",
+			},
+			expectedResult: expectedResult{
+				daytime: DayTime{},
+				err:     ErrInvalid,
+			},
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+
+			daytime, err := ParseOffset(test.args.value)
+			assert.EqualValues(tt, test.expectedResult.daytime, daytime)
+			assert.ErrorIs(tt, err, test.expectedResult.err)
+		})
+	}
+}
+
+func TestParseAllowEndOfDay(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		value string
+	}
+	type expectedResult struct {
+		daytime DayTime
+		err     error
+	}
+	tests := []struct {
+		name           string
+		args           args
+		expectedResult expectedResult
+	}{
+		{
+			name: "Checking standard work",
+			args: args{
+				value: "24:00",
+			},
+			expectedResult: expectedResult{
+				daytime: DayTime{hour: 24},
+				err:     nil,
+			},
+		},
+		{
+			name: "Checking the processing of seconds",
+			args: args{
+				value: " 24:00:00 ",
+			},
+			expectedResult: expectedResult{
+				daytime: DayTime{hour: 24},
+				err:     nil,
+			},
+		},
+		{
+			name: "Checking the processing of a regular value",
+			args: args{
+				value: "09:30",
+			},
+			expectedResult: expectedResult{
+				daytime: DayTime{hour: 9, minute: 30},
+				err:     nil,
+			},
+		},
+		{
+			name: "Checking the processing of a value after the end of the day",
+			args: args{
+				value: "24:00:01",
+			},
+			expectedResult: expectedResult{
+				daytime: DayTime{},
+				err:     ErrInvalid,
+			},
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+
+			daytime, err := ParseAllowEndOfDay(test.args.value)
+			assert.EqualValues(tt, test.expectedResult.daytime, daytime)
+			assert.ErrorIs(tt, err, test.expectedResult.err)
+		})
+	}
+}
+
+func TestEndOfDay(t *testing.T) {
+	t.Parallel()
+
+	endOfDay := DayTime{hour: 24}
+	lastSecond := DayTime{hour: 23, minute: 59, second: 59}
+	midnight := DayTime{}
+
+	assert.EqualValues(t, "24:00", endOfDay.String())
+	assert.EqualValues(t, Day, endOfDay.Duration())
+	assert.True(t, endOfDay.After(&lastSecond))
+	assert.True(t, endOfDay.After(&midnight))
+	assert.False(t, endOfDay.Equal(&midnight))
+	assert.True(t, endOfDay.IsMidnight())
+	assert.False(t, endOfDay.IsZero())
+
+	value, days := endOfDay.AddWithOverflow(time.Hour)
+	assert.EqualValues(t, DayTime{hour: 1}, value)
+	assert.EqualValues(t, 1, days)
+}
+
+func TestMustParse(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Checking standard work", func(tt *testing.T) {
+		tt.Parallel()
+
+		daytime := MustParse("01:02:03")
+		assert.EqualValues(tt, DayTime{hour: 1, minute: 2, second: 3}, daytime)
+	})
+	t.Run("Checking the processing of an invalid value", func(tt *testing.T) {
+		tt.Parallel()
+
+		assert.Panics(tt, func() {
+			MustParse("24:00")
+		})
+	})
+}
+
+func TestParseLenient(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		value string
+	}
+	type expectedResult struct {
+		daytime DayTime
+		err     error
+	}
+	tests := []struct {
+		name           string
+		args           args
+		expectedResult expectedResult
+	}{
+		{
+			name: "Checking the strict value",
+			args: args{
+				value: "09:05:03",
+			},
+			expectedResult: expectedResult{
+				daytime: DayTime{
+					hour:   9,
+					minute: 5,
+					second: 3,
+				},
+				err: nil,
+			},
+		},
+		{
+			name: "Checking a single digit hour",
+			args: args{
+				value: "9:05",
+			},
+			expectedResult: expectedResult{
+				daytime: DayTime{
+					hour:   9,
+					minute: 5,
+				},
+				err: nil,
+			},
+		},
+		{
+			name: "Checking single digit components",
+			args: args{
+				value: "9:5:3",
+			},
+			expectedResult: expectedResult{
+				daytime: DayTime{
+					hour:   9,
+					minute: 5,
+					second: 3,
+				},
+				err: nil,
+			},
+		},
+		{
+			name: "Checking the processing of an out of range hour",
+			args: args{
+				value: "99:00",
+			},
+			expectedResult: expectedResult{
+				daytime: DayTime{},
+				err:     ErrInvalid,
+			},
+		},
+		{
+			name: "Checking the processing of three digit component",
+			args: args{
+				value: "9:005",
+			},
+			expectedResult: expectedResult{
+				daytime: DayTime{},
+				err:     ErrInvalid,
+			},
+		},
+		{
+			name: "Checking the processing of a missing minute",
+			args: args{
+				value: "9",
+			},
+			expectedResult: expectedResult{
+				daytime: DayTime{},
+				err:     ErrInvalid,
+			},
+		},
+		{
+			name: "Checking the processing of too many components",
+			args: args{
+				value: "9:5:3:1",
+			},
+			expectedResult: expectedResult{
+				daytime: DayTime{},
+				err:     ErrInvalid,
+			},
+		},
+		{
+			name: "Checking the processing of a trailing separator",
+			args: args{
+				value: "9:5:",
+			},
+			expectedResult: expectedResult{
+				daytime: DayTime{},
+				err:     ErrInvalid,
+			},
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+
+			daytime, err := ParseLenient(test.args.value)
+			assert.EqualValues(tt, test.expectedResult.daytime, daytime)
+			assert.ErrorIs(tt, err, test.expectedResult.err)
+		})
+	}
+}
+
+func TestParse12(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		value string
+	}
+	type expectedResult struct {
+		daytime DayTime
+		err     error
+	}
+	tests := []struct {
+		name           string
+		args           args
+		expectedResult expectedResult
+	}{
+		{
+			name: "Checking 12 AM",
+			args: args{
+				value: "12:00 AM",
+			},
+			expectedResult: expectedResult{
+				daytime: DayTime{},
+				err:     nil,
+			},
+		},
+		{
+			name: "Checking 12 PM",
+			args: args{
+				value: "12:00 PM",
+			},
+			expectedResult: expectedResult{
+				daytime: DayTime{
+					hour: 12,
+				},
+				err: nil,
+			},
+		},
+		{
+			name: "Checking an hour in the morning",
+			args: args{
+				value: "1:02 AM",
+			},
+			expectedResult: expectedResult{
+				daytime: DayTime{
+					hour:   1,
+					minute: 2,
+				},
+				err: nil,
+			},
+		},
+		{
+			name: "Checking an hour in the evening with seconds and lower case",
+			args: args{
+				value: "11:30:15 pm",
+			},
+			expectedResult: expectedResult{
+				daytime: DayTime{
+					hour:   23,
+					minute: 30,
+					second: 15,
+				},
+				err: nil,
+			},
+		},
+		{
+			name: "Checking the processing of an out of range hour",
+			args: args{
+				value: "13:00 PM",
+			},
+			expectedResult: expectedResult{
+				daytime: DayTime{},
+				err:     ErrInvalid,
+			},
+		},
+		{
+			name: "Checking the processing of a missing meridiem",
+			args: args{
+				value: "11:00",
+			},
+			expectedResult: expectedResult{
+				daytime: DayTime{},
+				err:     ErrInvalid,
+			},
+		},
+		{
+			name: "Checking the processing of a malformed value",
+			args: args{
+				value: "11-00 AM",
+			},
+			expectedResult: expectedResult{
+				daytime: DayTime{},
+				err:     ErrInvalid,
+			},
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+
+			daytime, err := Parse12(test.args.value)
+			assert.EqualValues(tt, test.expectedResult.daytime, daytime)
+			assert.ErrorIs(tt, err, test.expectedResult.err)
+		})
+	}
+}
+
+func TestParseWithOffset(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		value string
+	}
+	type expectedResult struct {
+		daytime DayTime
+		err     error
+	}
+	tests := []struct {
+		name           string
+		args           args
+		expectedResult expectedResult
+	}{
+		{
+			name: "Checking standard work",
+			args: args{
+				value: "09:00+03:00",
+			},
+			expectedResult: expectedResult{
+				daytime: DayTime{hour: 6},
+				err:     nil,
+			},
+		},
+		{
+			name: "Checking the processing of UTC",
+			args: args{
+				value: "09:00Z",
+			},
+			expectedResult: expectedResult{
+				daytime: DayTime{hour: 9},
+				err:     nil,
+			},
+		},
+		{
+			name: "Checking the processing of a negative offset around midnight",
+			args: args{
+				value: "22:15:30-05:30",
+			},
+			expectedResult: expectedResult{
+				daytime: DayTime{hour: 3, minute: 45, second: 30},
+				err:     nil,
+			},
+		},
+		{
+			name: "Checking the value without an offset",
+			args: args{
+				value: "09:00",
+			},
+			expectedResult: expectedResult{
+				daytime: DayTime{hour: 9},
+				err:     nil,
+			},
+		},
+		{
+			name: "Checking the processing of a malformed offset",
+			args: args{
+				value: "09:00+3",
+			},
+			expectedResult: expectedResult{
+				daytime: DayTime{},
+				err:     ErrInvalid,
+			},
+		},
+		{
+			name: "Checking the processing of an out of range offset",
+			args: args{
+				value: "09:00+03:60",
+			},
+			expectedResult: expectedResult{
+				daytime: DayTime{},
+				err:     ErrInvalid,
+			},
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+
+			daytime, err := ParseWithOffset(test.args.value)
+			assert.EqualValues(tt, test.expectedResult.daytime, daytime)
+			assert.ErrorIs(tt, err, test.expectedResult.err)
+		})
+	}
+}
+
+func TestParseReader(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		value string
+	}
+	type expectedResult struct {
+		daytime DayTime
+		rest    string
+		err     error
+	}
+	tests := []struct {
+		name           string
+		args           args
+		expectedResult expectedResult
+	}{
+		{
+			name: "Checking standard work",
+			args: args{
+				value: "09:30 rest",
+			},
+			expectedResult: expectedResult{
+				daytime: DayTime{hour: 9, minute: 30},
+				rest:    " rest",
+				err:     nil,
+			},
+		},
+		{
+			name: "Checking the value with seconds",
+			args: args{
+				value: "09:30:15,next",
+			},
+			expectedResult: expectedResult{
+				daytime: DayTime{hour: 9, minute: 30, second: 15},
+				rest:    ",next",
+				err:     nil,
+			},
+		},
+		{
+			name: "Checking the value at the end",
+			args: args{
+				value: "23:59",
+			},
+			expectedResult: expectedResult{
+				daytime: DayTime{hour: 23, minute: 59},
+				rest:    "",
+				err:     nil,
+			},
+		},
+		{
+			name: "Checking the processing of a single digit hour",
+			args: args{
+				value: "9:30",
+			},
+			expectedResult: expectedResult{
+				daytime: DayTime{},
+				rest:    ":30",
+				err:     ErrInvalid,
+			},
+		},
+		{
+			name: "Checking the processing of a truncated token",
+			args: args{
+				value: "09:3",
+			},
+			expectedResult: expectedResult{
+				daytime: DayTime{},
+				rest:    "",
+				err:     ErrInvalid,
+			},
+		},
+		{
+			name: "Checking the value followed by a colon",
+			args: args{
+				value: "09:00: rest",
+			},
+			expectedResult: expectedResult{
+				daytime: DayTime{hour: 9},
+				rest:    " rest",
+				err:     nil,
+			},
+		},
+		{
+			name: "Checking the value followed by a colon at the end",
+			args: args{
+				value: "09:00:",
+			},
+			expectedResult: expectedResult{
+				daytime: DayTime{hour: 9},
+				rest:    "",
+				err:     nil,
+			},
+		},
+		{
+			name: "Checking the processing of a truncated second",
+			args: args{
+				value: "09:30:1x",
+			},
+			expectedResult: expectedResult{
+				daytime: DayTime{},
+				rest:    "x",
+				err:     ErrInvalid,
+			},
+		},
+		{
+			name: "Checking the processing of an out of range value",
+			args: args{
+				value: "25:00 rest",
+			},
+			expectedResult: expectedResult{
+				daytime: DayTime{},
+				rest:    " rest",
+				err:     ErrInvalid,
+			},
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+
+			reader := strings.NewReader(test.args.value)
+			daytime, err := ParseReader(reader)
+			assert.EqualValues(tt, test.expectedResult.daytime, daytime)
+			assert.ErrorIs(tt, err, test.expectedResult.err)
+
+			rest, _ := io.ReadAll(reader)
+			assert.EqualValues(tt, test.expectedResult.rest, string(rest))
+		})
+	}
+}
+
+func TestParseReaderWithPeek(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		value          string
+		expectedResult DayTime
+		rest           string
+	}{
+		{
+			name:           "Checking the value followed by a colon",
+			value:          "09:00: rest",
+			expectedResult: DayTime{hour: 9},
+			rest:           ": rest",
+		},
+		{
+			name:           "Checking the value with seconds",
+			value:          "09:00:15: rest",
+			expectedResult: DayTime{hour: 9, second: 15},
+			rest:           ": rest",
+		},
+		{
+			name:           "Checking the value at the end",
+			value:          "09:00",
+			expectedResult: DayTime{hour: 9},
+			rest:           "",
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+
+			reader := bufio.NewReader(strings.NewReader(test.value))
+			daytime, err := ParseReader(reader)
+			assert.EqualValues(tt, test.expectedResult, daytime)
+			assert.NoError(tt, err)
+
+			rest, _ := io.ReadAll(reader)
+			assert.EqualValues(tt, test.rest, string(rest))
+		})
+	}
+}
+
+func TestParseCompact(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		value string
+	}
+	type expectedResult struct {
+		daytime DayTime
+		err     error
+	}
+	tests := []struct {
+		name           string
+		args           args
+		expectedResult expectedResult
+	}{
+		{
+			name: "Checking standard work",
+			args: args{
+				value: "0900",
+			},
+			expectedResult: expectedResult{
+				daytime: DayTime{hour: 9},
+				err:     nil,
+			},
+		},
+		{
+			name: "Checking the processing of seconds",
+			args: args{
+				value: "090015",
+			},
+			expectedResult: expectedResult{
+				daytime: DayTime{hour: 9, second: 15},
+				err:     nil,
+			},
+		},
+		{
+			name: "Checking the processing of whitespace",
+			args: args{
+				value: " 2359 ",
+			},
+			expectedResult: expectedResult{
+				daytime: DayTime{hour: 23, minute: 59},
+				err:     nil,
+			},
+		},
+		{
+			name: "Checking the processing of an out of range value",
+			args: args{
+				value: "9000",
+			},
+			expectedResult: expectedResult{
+				daytime: DayTime{},
+				err:     ErrInvalid,
+			},
+		},
+		{
+			name: "Checking the processing of non-digit characters",
+			args: args{
+				value: "abcd",
+			},
+			expectedResult: expectedResult{
+				daytime: DayTime{},
+				err:     ErrInvalid,
+			},
+		},
+		{
+			name: "Checking the processing of a wrong length",
+			args: args{
+				value: "09000",
+			},
+			expectedResult: expectedResult{
+				daytime: DayTime{},
+				err:     ErrInvalid,
+			},
+		},
+		{
+			name: "Checking the processing of separators",
+			args: args{
+				value: "09:00",
+			},
+			expectedResult: expectedResult{
+				daytime: DayTime{},
+				err:     ErrInvalid,
+			},
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+
+			daytime, err := ParseCompact(test.args.value)
+			assert.EqualValues(tt, test.expectedResult.daytime, daytime)
+			assert.ErrorIs(tt, err, test.expectedResult.err)
+		})
+	}
+}
+
+func TestParseWithZone(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		value string
+	}
+	type expectedResult struct {
+		daytime DayTime
+		loc     *time.Location
+		err     error
+	}
+	tests := []struct {
+		name           string
+		args           args
+		expectedResult expectedResult
+	}{
+		{
+			name: "Checking standard work",
+			args: args{
+				value: "09:00 UTC",
+			},
+			expectedResult: expectedResult{
+				daytime: DayTime{hour: 9},
+				loc:     time.UTC,
+				err:     nil,
+			},
+		},
+		{
+			name: "Checking the processing of an abbreviation",
+			args: args{
+				value: " 17:30:15  MSK ",
+			},
+			expectedResult: expectedResult{
+				daytime: DayTime{hour: 17, minute: 30, second: 15},
+				loc:     zoneAbbreviations["MSK"],
+				err:     nil,
+			},
+		},
+		{
+			name: "Checking the processing of a missing zone",
+			args: args{
+				value: "17:30",
+			},
+			expectedResult: expectedResult{
+				daytime: DayTime{hour: 17, minute: 30},
+				loc:     nil,
+				err:     nil,
+			},
+		},
+		{
+			name: "Checking the processing of an unknown zone",
+			args: args{
+				value: "17:30 Mars/Olympus",
+			},
+			expectedResult: expectedResult{
+				daytime: DayTime{},
+				loc:     nil,
+				err:     ErrInvalid,
+			},
+		},
+		{
+			name: "Checking the processing of an invalid daytime",
+			args: args{
+				value: "25:00 UTC",
+			},
+			expectedResult: expectedResult{
+				daytime: DayTime{},
+				loc:     nil,
+				err:     ErrInvalid,
+			},
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+
+			daytime, loc, err := ParseWithZone(test.args.value)
+			assert.EqualValues(tt, test.expectedResult.daytime, daytime)
+			assert.Same(tt, test.expectedResult.loc, loc)
+			assert.ErrorIs(tt, err, test.expectedResult.err)
+		})
+	}
+}
+
+func TestFromTime(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		t time.Time
+	}
+	tests := []struct {
+		name           string
+		args           args
+		expectedResult DayTime
+	}{
+		{
+			name: "Checking standard work",
+			args: args{
+				t: time.Date(2024, time.March, 15, 1, 2, 3, 999999999, time.UTC),
+			},
+			expectedResult: DayTime{
+				hour:   1,
+				minute: 2,
+				second: 3,
+			},
+		},
+		{
+			name: "Checking to process midnight",
+			args: args{
+				t: time.Date(2024, time.March, 15, 0, 0, 0, 0, time.UTC),
+			},
+			expectedResult: DayTime{},
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+
+			daytime := FromTime(test.args.t)
+			assert.EqualValues(tt, test.expectedResult, daytime)
+		})
+	}
+}
+
+func TestNow(t *testing.T) {
+	nowFunc = func() time.Time {
+		return time.Date(2024, time.March, 15, 1, 2, 3, 4, time.Local)
+	}
+	t.Cleanup(func() {
+		nowFunc = time.Now
+	})
+
+	daytime := Now()
+	assert.EqualValues(t, DayTime{hour: 1, minute: 2, second: 3}, daytime)
+}
+
+func TestFromDuration(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		d time.Duration
+	}
+	type expectedResult struct {
+		daytime DayTime
+		err     error
+	}
+	tests := []struct {
+		name           string
+		args           args
+		expectedResult expectedResult
+	}{
+		{
+			name: "Checking to process zero",
+			args: args{
+				d: 0,
+			},
+			expectedResult: expectedResult{
+				daytime: DayTime{},
+				err:     nil,
+			},
+		},
+		{
+			name: "Checking to process the last second of the day",
+			args: args{
+				d: Day - time.Nanosecond,
+			},
+			expectedResult: expectedResult{
+				daytime: DayTime{
+					hour:   23,
+					minute: 59,
+					second: 59,
+				},
+				err: nil,
+			},
+		},
+		{
+			name: "Checking to process a whole day",
+			args: args{
+				d: Day,
+			},
+			expectedResult: expectedResult{
+				daytime: DayTime{},
+				err:     ErrInvalid,
+			},
+		},
+		{
+			name: "Checking to process a negative duration",
+			args: args{
+				d: -time.Second,
+			},
+			expectedResult: expectedResult{
+				daytime: DayTime{},
+				err:     ErrInvalid,
+			},
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+
+			daytime, err := FromDuration(test.args.d)
+			assert.EqualValues(tt, test.expectedResult.daytime, daytime)
+			assert.ErrorIs(tt, err, test.expectedResult.err)
+		})
+	}
+}
+
+func TestNewFromComponentsAndClock(t *testing.T) {
+	t.Parallel()
+
+	for _, d := range []time.Duration{9*time.Hour + 30*time.Minute, Day, -time.Second} {
+		expected, expectedErr := FromDuration(d)
+		value, err := NewFromComponents(d)
+		assert.EqualValues(t, expected, value)
+		assert.EqualValues(t, expectedErr == nil, err == nil)
+		if expectedErr != nil {
+			assert.ErrorIs(t, err, ErrInvalid)
+		}
+	}
+
+	for _, str := range []string{"09:30", "09:30:15.250", "24:00", ""} {
+		expected, expectedErr := Parse(str)
+		value, err := NewFromClock(str)
+		assert.EqualValues(t, expected, value)
+		assert.EqualValues(t, expectedErr == nil, err == nil)
+		if expectedErr != nil {
+			assert.ErrorIs(t, err, ErrInvalid)
+		}
+	}
+}
+
+func TestFromSeconds(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		seconds int
+	}
+	type expectedResult struct {
+		daytime DayTime
+		err     error
+	}
+	tests := []struct {
+		name           string
+		args           args
+		expectedResult expectedResult
+	}{
+		{
+			name: "Checking to process zero",
+			args: args{
+				seconds: 0,
+			},
+			expectedResult: expectedResult{
+				daytime: DayTime{},
+				err:     nil,
+			},
+		},
+		{
+			name: "Checking standard work",
+			args: args{
+				seconds: 3723,
+			},
+			expectedResult: expectedResult{
+				daytime: DayTime{
+					hour:   1,
+					minute: 2,
+					second: 3,
+				},
+				err: nil,
+			},
+		},
+		{
+			name: "Checking to process the last second of the day",
+			args: args{
+				seconds: 86399,
+			},
+			expectedResult: expectedResult{
+				daytime: DayTime{
+					hour:   23,
+					minute: 59,
+					second: 59,
+				},
+				err: nil,
+			},
+		},
+		{
+			name: "Checking to process a whole day",
+			args: args{
+				seconds: 86400,
+			},
+			expectedResult: expectedResult{
+				daytime: DayTime{},
+				err:     ErrInvalid,
+			},
+		},
+		{
+			name: "Checking to process a negative value",
+			args: args{
+				seconds: -1,
+			},
+			expectedResult: expectedResult{
+				daytime: DayTime{},
+				err:     ErrInvalid,
+			},
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+
+			daytime, err := FromSeconds(test.args.seconds)
+			assert.EqualValues(tt, test.expectedResult.daytime, daytime)
+			assert.ErrorIs(tt, err, test.expectedResult.err)
+		})
+	}
+}
+
+func TestString(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		daytime        *DayTime
+		expectedResult string
+	}{
+		{
+			name: "Checking to get the full value",
+			daytime: &DayTime{
+				hour:   1,
+				minute: 2,
+				second: 3,
+			},
+			expectedResult: "01:02:03",
+		},
+		{
+			name:           "Checking to get the default value",
+			daytime:        nil,
+			expectedResult: "00:00",
+		},
+		{
+			name: "Checking to get the value without second",
+			daytime: &DayTime{
+				hour:   1,
+				minute: 2,
+				second: 0,
+			},
+			expectedResult: "01:02",
+		},
+		{
+			name: "Checking to get the value with two-digit components",
+			daytime: &DayTime{
+				hour:   23,
+				minute: 45,
+				second: 10,
+			},
+			expectedResult: "23:45:10",
+		},
+		{
+			name: "Checking to get the value with milliseconds",
+			daytime: &DayTime{
+				hour:       1,
+				minute:     2,
+				second:     3,
+				nanosecond: 250000000,
+			},
+			expectedResult: "01:02:03.250",
+		},
+		{
+			name: "Checking to get the value with microseconds and without second",
+			daytime: &DayTime{
+				hour:       1,
+				minute:     2,
+				nanosecond: 1000,
+			},
+			expectedResult: "01:02:00.000001",
+		},
+		{
+			name: "Checking to get the value with nanoseconds",
+			daytime: &DayTime{
+				hour:       1,
+				minute:     2,
+				second:     3,
+				nanosecond: 1,
+			},
+			expectedResult: "01:02:03.000000001",
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+
+			value := test.daytime.String()
+			assert.EqualValues(tt, test.expectedResult, value)
+		})
+	}
+}
+
+func TestStringWithSeconds(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		daytime        *DayTime
+		expectedResult string
+	}{
+		{
+			name:           "Checking to get the full value",
+			daytime:        &DayTime{hour: 1, minute: 2, second: 3},
+			expectedResult: "01:02:03",
+		},
+		{
+			name:           "Checking to get the value without second",
+			daytime:        &DayTime{hour: 9},
+			expectedResult: "09:00:00",
+		},
+		{
+			name:           "Checking to get the value with nanoseconds",
+			daytime:        &DayTime{hour: 9, nanosecond: 250000000},
+			expectedResult: "09:00:00.250",
+		},
+		{
+			name:           "Checking to get the zero value",
+			daytime:        &DayTime{},
+			expectedResult: "00:00:00",
+		},
+		{
+			name:           "Checking to process nil",
+			daytime:        nil,
+			expectedResult: "00:00:00",
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+
+			value := test.daytime.StringWithSeconds()
+			assert.EqualValues(tt, test.expectedResult, value)
+		})
+	}
+}
+
+func TestStringSep(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		sep string
+	}
+	tests := []struct {
+		name           string
+		daytime        *DayTime
+		args           args
+		expectedResult string
+	}{
+		{
+			name:    "Checking the dot separator",
+			daytime: &DayTime{hour: 1, minute: 2, second: 3},
+			args: args{
+				sep: ".",
+			},
+			expectedResult: "01.02.03",
+		},
+		{
+			name:    "Checking the dash separator",
+			daytime: &DayTime{hour: 1, minute: 2},
+			args: args{
+				sep: "-",
+			},
+			expectedResult: "01-02",
+		},
+		{
+			name:    "Checking the empty separator",
+			daytime: &DayTime{hour: 1, minute: 2, second: 3},
+			args: args{
+				sep: "",
+			},
+			expectedResult: "010203",
+		},
+		{
+			name:    "Checking the long separator",
+			daytime: &DayTime{hour: 1, minute: 2, nanosecond: 500000000},
+			args: args{
+				sep: " : ",
+			},
+			expectedResult: "01 : 02 : 00.500",
+		},
+		{
+			name:    "Checking to process nil",
+			daytime: nil,
+			args: args{
+				sep: ".",
+			},
+			expectedResult: "00.00",
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+
+			value := test.daytime.StringSep(test.args.sep)
+			assert.EqualValues(tt, test.expectedResult, value)
+		})
+	}
+}
+
+func TestAppendFormat(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		b []byte
+	}
+	tests := []struct {
+		name           string
+		daytime        *DayTime
+		args           args
+		expectedResult []byte
+	}{
+		{
+			name: "Checking standard work",
+			daytime: &DayTime{
+				hour:   1,
+				minute: 2,
+				second: 3,
+			},
+			args: args{
+				b: []byte("start="),
+			},
+			expectedResult: []byte("start=01:02:03"),
+		},
+		{
+			name: "Checking to append the value without second",
+			daytime: &DayTime{
+				hour:   1,
+				minute: 2,
+			},
+			args: args{
+				b: nil,
+			},
+			expectedResult: []byte("01:02"),
+		},
+		{
+			name:    "Checking to process nil",
+			daytime: nil,
+			args: args{
+				b: []byte("start="),
+			},
+			expectedResult: []byte("start=00:00"),
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+
+			value := test.daytime.AppendFormat(test.args.b)
+			assert.EqualValues(tt, test.expectedResult, value)
+		})
+	}
+}
+
+func TestFormat(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		layout string
+	}
+	tests := []struct {
+		name           string
+		daytime        *DayTime
+		args           args
+		expectedResult string
+	}{
+		{
+			name: "Checking the 24-hour layout",
+			daytime: &DayTime{
+				hour:   15,
+				minute: 4,
+				second: 5,
+			},
+			args: args{
+				layout: "15:04",
+			},
+			expectedResult: "15:04",
+		},
+		{
+			name: "Checking the 12-hour layout",
+			daytime: &DayTime{
+				hour:   15,
+				minute: 4,
+			},
+			args: args{
+				layout: "3:04 PM",
+			},
+			expectedResult: "3:04 PM",
+		},
+		{
+			name: "Checking the padded 12-hour layout in the morning",
+			daytime: &DayTime{
+				hour:   0,
+				minute: 30,
+			},
+			args: args{
+				layout: "03:04pm",
+			},
+			expectedResult: "12:30am",
+		},
+		{
+			name: "Checking the layout with seconds",
+			daytime: &DayTime{
+				hour:   1,
+				minute: 2,
+				second: 3,
+			},
+			args: args{
+				layout: "15:04:05",
+			},
+			expectedResult: "01:02:03",
+		},
+		{
+			name: "Checking the unpadded layout with literal text",
+			daytime: &DayTime{
+				hour:   9,
+				minute: 7,
+				second: 5,
+			},
+			args: args{
+				layout: "3h 4m 5s",
+			},
+			expectedResult: "9h 7m 5s",
+		},
+		{
+			name:    "Checking the 12-hour layout at the end of the day",
+			daytime: &DayTime{hour: 24},
+			args: args{
+				layout: "3:04 PM",
+			},
+			expectedResult: "12:00 AM",
+		},
+		{
+			name:    "Checking to process nil",
+			daytime: nil,
+			args: args{
+				layout: "15:04:05",
+			},
+			expectedResult: "00:00:00",
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+
+			value := test.daytime.Format(test.args.layout)
+			assert.EqualValues(tt, test.expectedResult, value)
+		})
+	}
+}
+
+func TestString12(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		daytime        *DayTime
+		expectedResult string
+	}{
+		{
+			name:           "Checking midnight",
+			daytime:        &DayTime{},
+			expectedResult: "12:00 AM",
+		},
+		{
+			name: "Checking noon",
+			daytime: &DayTime{
+				hour: 12,
+			},
+			expectedResult: "12:00 PM",
+		},
+		{
+			name: "Checking half past midnight",
+			daytime: &DayTime{
+				minute: 30,
+			},
+			expectedResult: "12:30 AM",
+		},
+		{
+			name: "Checking half past noon",
+			daytime: &DayTime{
+				hour:   12,
+				minute: 30,
+			},
+			expectedResult: "12:30 PM",
+		},
+		{
+			name: "Checking the end of the day",
+			daytime: &DayTime{
+				hour:   23,
+				minute: 59,
+			},
+			expectedResult: "11:59 PM",
+		},
+		{
+			name:           "Checking the end of the day 24:00",
+			daytime:        &DayTime{hour: 24},
+			expectedResult: "12:00 AM",
+		},
+		{
+			name: "Checking the value with seconds",
+			daytime: &DayTime{
+				hour:   1,
+				minute: 2,
+				second: 3,
+			},
+			expectedResult: "1:02:03 AM",
+		},
+		{
+			name:           "Checking to process nil",
+			daytime:        nil,
+			expectedResult: "12:00 AM",
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+
+			value := test.daytime.String12()
+			assert.EqualValues(tt, test.expectedResult, value)
+		})
+	}
+}
+
+func TestGoString(t *testing.T) {
+	t.Parallel()
+
+	type schedule struct {
+		Start DayTime
+	}
+	tests := []struct {
+		name           string
+		value          any
+		expectedResult string
+	}{
+		{
+			name: "Checking standard work",
+			value: DayTime{
+				hour:   1,
+				minute: 2,
+				second: 3,
+			},
+			expectedResult: `daytime.MustParse("01:02:03")`,
+		},
+		{
+			name: "Checking to process nanoseconds",
+			value: &DayTime{
+				hour:       1,
+				minute:     2,
+				nanosecond: 500000000,
+			},
+			expectedResult: `daytime.MustParse("01:02:00.500")`,
+		},
+		{
+			name: "Checking to process a struct field",
+			value: schedule{
+				Start: DayTime{hour: 9},
+			},
+			expectedResult: `daytime.schedule{Start:daytime.MustParse("09:00")}`,
+		},
+		{
+			name:           "Checking to process the end of the day",
+			value:          DayTime{hour: 24},
+			expectedResult: `daytime.MustParseAllowEndOfDay("24:00")`,
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+
+			assert.EqualValues(tt, test.expectedResult, fmt.Sprintf("%#v", test.value))
+		})
+	}
+}
+
+func TestClock(t *testing.T) {
+	t.Parallel()
+
+	type expectedResult struct {
+		hour   int
+		minute int
+		second int
+	}
+	tests := []struct {
+		name           string
+		daytime        *DayTime
+		expectedResult expectedResult
+	}{
+		{
+			name: "Checking standard work",
+			daytime: &DayTime{
+				hour:   1,
+				minute: 2,
+				second: 3,
+			},
+			expectedResult: expectedResult{
+				hour:   1,
+				minute: 2,
+				second: 3,
+			},
+		},
+		{
+			name:           "Checking to process nil",
+			daytime:        nil,
+			expectedResult: expectedResult{},
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+
+			hour, minute, second := test.daytime.Clock()
+			assert.EqualValues(tt, test.expectedResult.hour, hour)
+			assert.EqualValues(tt, test.expectedResult.minute, minute)
+			assert.EqualValues(tt, test.expectedResult.second, second)
+		})
+	}
+}
+
+func TestClone(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		daytime *DayTime
+	}{
+		{
+			name: "Checking standard work",
+			daytime: &DayTime{
+				hour:       1,
+				minute:     2,
+				second:     3,
+				nanosecond: 4,
+			},
+		},
+		{
+			name:    "Checking to process nil",
+			daytime: nil,
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+
+			value := test.daytime.Clone()
+			assert.EqualValues(tt, test.daytime, value)
+			if test.daytime == nil {
+				assert.Nil(tt, value)
+
+				return
+			}
+
+			assert.NotSame(tt, test.daytime, value)
+			value.hour = 23
+			assert.NotEqualValues(tt, test.daytime.hour, value.hour)
+		})
+	}
+}
+
+func TestHasSeconds(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		daytime        *DayTime
+		expectedResult bool
+	}{
+		{
+			name:           "Checking the value with seconds",
+			daytime:        &DayTime{hour: 9, second: 15},
+			expectedResult: true,
+		},
+		{
+			name:           "Checking the value without seconds",
+			daytime:        &DayTime{hour: 9, minute: 30},
+			expectedResult: false,
+		},
+		{
+			name:           "Checking to process nil",
+			daytime:        nil,
+			expectedResult: false,
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+
+			assert.EqualValues(tt, test.expectedResult, test.daytime.HasSeconds())
+		})
+	}
+}
+
+func TestIsZeroAndIsMidnight(t *testing.T) {
+	t.Parallel()
+
+	type expectedResult struct {
+		isZero     bool
+		isMidnight bool
+	}
+	tests := []struct {
+		name           string
+		daytime        *DayTime
+		expectedResult expectedResult
+	}{
+		{
+			name:    "Checking to process midnight",
+			daytime: &DayTime{},
+			expectedResult: expectedResult{
+				isZero:     true,
+				isMidnight: true,
+			},
+		},
+		{
+			name: "Checking the processing of a second after midnight",
+			daytime: &DayTime{
+				second: 1,
+			},
+			expectedResult: expectedResult{},
+		},
+		{
+			name: "Checking the processing of a nanosecond after midnight",
+			daytime: &DayTime{
+				nanosecond: 1,
+			},
+			expectedResult: expectedResult{},
+		},
+		{
+			name:    "Checking to process nil",
+			daytime: nil,
+			expectedResult: expectedResult{
+				isZero:     true,
+				isMidnight: true,
+			},
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+
+			assert.EqualValues(tt, test.expectedResult.isZero, test.daytime.IsZero())
+			assert.EqualValues(tt, test.expectedResult.isMidnight, test.daytime.IsMidnight())
+		})
+	}
+}
+
+func TestWith(t *testing.T) {
+	t.Parallel()
+
+	daytime := DayTime{
+		hour:       1,
+		minute:     2,
+		second:     3,
+		nanosecond: 4,
+	}
+
+	type expectedResult struct {
+		daytime DayTime
+		err     error
+	}
+	tests := []struct {
+		name           string
+		with           func(DayTime) (DayTime, error)
+		expectedResult expectedResult
+	}{
+		{
+			name: "Checking to replace the hour",
+			with: func(t DayTime) (DayTime, error) { return t.WithHour(23) },
+			expectedResult: expectedResult{
+				daytime: DayTime{hour: 23, minute: 2, second: 3, nanosecond: 4},
+			},
+		},
+		{
+			name: "Checking to replace the minute",
+			with: func(t DayTime) (DayTime, error) { return t.WithMinute(59) },
+			expectedResult: expectedResult{
+				daytime: DayTime{hour: 1, minute: 59, second: 3, nanosecond: 4},
+			},
+		},
+		{
+			name: "Checking to replace the second",
+			with: func(t DayTime) (DayTime, error) { return t.WithSecond(0) },
+			expectedResult: expectedResult{
+				daytime: DayTime{hour: 1, minute: 2, second: 0, nanosecond: 4},
+			},
+		},
+		{
+			name: "Checking the processing of an invalid hour",
+			with: func(t DayTime) (DayTime, error) { return t.WithHour(24) },
+			expectedResult: expectedResult{
+				err: ErrInvalid,
+			},
+		},
+		{
+			name: "Checking the processing of an invalid minute",
+			with: func(t DayTime) (DayTime, error) { return t.WithMinute(-1) },
+			expectedResult: expectedResult{
+				err: ErrInvalid,
+			},
+		},
+		{
+			name: "Checking the processing of an invalid second",
+			with: func(t DayTime) (DayTime, error) { return t.WithSecond(60) },
+			expectedResult: expectedResult{
+				err: ErrInvalid,
+			},
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+
+			value, err := test.with(daytime)
+			assert.EqualValues(tt, test.expectedResult.daytime, value)
+			assert.ErrorIs(tt, err, test.expectedResult.err)
+			assert.EqualValues(tt, DayTime{hour: 1, minute: 2, second: 3, nanosecond: 4}, daytime)
+		})
+	}
+}
+
+func TestComparison(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		other *DayTime
+	}
+	type expectedResult struct {
+		before bool
+		after  bool
+		equal  bool
+	}
+	tests := []struct {
+		name           string
+		daytime        *DayTime
+		args           args
+		expectedResult expectedResult
+	}{
+		{
+			name: "Checking the earlier value",
+			daytime: &DayTime{
+				hour:   1,
+				minute: 2,
+				second: 3,
+			},
+			args: args{
+				other: &DayTime{
+					hour:   1,
+					minute: 2,
+					second: 4,
+				},
+			},
+			expectedResult: expectedResult{
+				before: true,
+			},
+		},
+		{
+			name: "Checking the later value",
+			daytime: &DayTime{
+				hour:   2,
+				minute: 0,
+				second: 0,
+			},
+			args: args{
+				other: &DayTime{
+					hour:   1,
+					minute: 59,
+					second: 59,
+				},
+			},
+			expectedResult: expectedResult{
+				after: true,
+			},
+		},
+		{
+			name: "Checking equal values",
+			daytime: &DayTime{
+				hour:   1,
+				minute: 2,
+				second: 3,
+			},
+			args: args{
+				other: &DayTime{
+					hour:   1,
+					minute: 2,
+					second: 3,
+				},
+			},
+			expectedResult: expectedResult{
+				equal: true,
+			},
+		},
+		{
+			name:    "Checking to process nil receiver",
+			daytime: nil,
+			args: args{
+				other: &DayTime{
+					hour:   1,
+					minute: 2,
+					second: 3,
+				},
+			},
+			expectedResult: expectedResult{
+				before: true,
+			},
+		},
+		{
+			name: "Checking to process nil argument",
+			daytime: &DayTime{
+				hour:   1,
+				minute: 2,
+				second: 3,
+			},
+			args: args{
+				other: nil,
+			},
+			expectedResult: expectedResult{
+				after: true,
+			},
+		},
+		{
+			name:    "Checking to process nil on both sides",
+			daytime: nil,
+			args: args{
+				other: nil,
+			},
+			expectedResult: expectedResult{
+				equal: true,
+			},
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+
+			assert.EqualValues(tt, test.expectedResult.before, test.daytime.Before(test.args.other))
+			assert.EqualValues(tt, test.expectedResult.after, test.daytime.After(test.args.other))
+			assert.EqualValues(tt, test.expectedResult.equal, test.daytime.Equal(test.args.other))
+		})
+	}
+}
+
+func TestEqual(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		other *DayTime
+	}
+	tests := []struct {
+		name           string
+		daytime        *DayTime
+		args           args
+		expectedResult bool
+	}{
+		{
+			name:           "Checking nil and nil",
+			daytime:        nil,
+			args:           args{other: nil},
+			expectedResult: true,
+		},
+		{
+			name:           "Checking nil and zero",
+			daytime:        nil,
+			args:           args{other: &DayTime{}},
+			expectedResult: true,
+		},
+		{
+			name:           "Checking zero and nil",
+			daytime:        &DayTime{},
+			args:           args{other: nil},
+			expectedResult: true,
+		},
+		{
+			name:           "Checking zero and zero",
+			daytime:        &DayTime{},
+			args:           args{other: &DayTime{}},
+			expectedResult: true,
+		},
+		{
+			name:           "Checking nil and non-zero",
+			daytime:        nil,
+			args:           args{other: &DayTime{second: 1}},
+			expectedResult: false,
+		},
+		{
+			name:           "Checking non-zero and nil",
+			daytime:        &DayTime{nanosecond: 1},
+			args:           args{other: nil},
+			expectedResult: false,
+		},
+		{
+			name:           "Checking zero and non-zero",
+			daytime:        &DayTime{},
+			args:           args{other: &DayTime{hour: 1}},
+			expectedResult: false,
+		},
+		{
+			name:           "Checking non-zero and equal non-zero",
+			daytime:        &DayTime{hour: 1, minute: 2, second: 3},
+			args:           args{other: &DayTime{hour: 1, minute: 2, second: 3}},
+			expectedResult: true,
+		},
+		{
+			name:           "Checking non-zero and different non-zero",
+			daytime:        &DayTime{hour: 1, minute: 2, second: 3},
+			args:           args{other: &DayTime{hour: 1, minute: 2, second: 4}},
+			expectedResult: false,
+		},
+		{
+			name:           "Checking nil and the end of the day",
+			daytime:        nil,
+			args:           args{other: &DayTime{hour: 24}},
+			expectedResult: false,
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+
+			value := test.daytime.Equal(test.args.other)
+			assert.EqualValues(tt, test.expectedResult, value)
+		})
+	}
+}
+
+func TestEqualClock(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		other *DayTime
+	}
+	tests := []struct {
+		name           string
+		daytime        *DayTime
+		args           args
+		expectedResult bool
+	}{
+		{
+			name:           "Checking the values differing in seconds",
+			daytime:        &DayTime{hour: 9, minute: 30, second: 15},
+			args:           args{other: &DayTime{hour: 9, minute: 30, second: 59, nanosecond: 1}},
+			expectedResult: true,
+		},
+		{
+			name:           "Checking the values differing in minutes",
+			daytime:        &DayTime{hour: 9, minute: 30},
+			args:           args{other: &DayTime{hour: 9, minute: 31}},
+			expectedResult: false,
+		},
+		{
+			name:           "Checking the values differing in hours",
+			daytime:        &DayTime{hour: 9, minute: 30},
+			args:           args{other: &DayTime{hour: 10, minute: 30}},
+			expectedResult: false,
+		},
+		{
+			name:           "Checking to process nil",
+			daytime:        nil,
+			args:           args{other: &DayTime{second: 30}},
+			expectedResult: true,
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+
+			value := test.daytime.EqualClock(test.args.other)
+			assert.EqualValues(tt, test.expectedResult, value)
+		})
+	}
+}
+
+func TestCompareTime(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		ts time.Time
+	}
+	tests := []struct {
+		name           string
+		daytime        *DayTime
+		args           args
+		expectedResult int
+	}{
+		{
+			name:           "Checking the time after the value",
+			daytime:        &DayTime{hour: 9, minute: 30},
+			args:           args{ts: time.Date(2024, time.March, 15, 9, 30, 1, 0, time.UTC)},
+			expectedResult: -1,
+		},
+		{
+			name:           "Checking the equal time",
+			daytime:        &DayTime{hour: 9, minute: 30},
+			args:           args{ts: time.Date(2024, time.March, 15, 9, 30, 0, 0, time.UTC)},
+			expectedResult: 0,
+		},
+		{
+			name:           "Checking the time before the value",
+			daytime:        &DayTime{hour: 9, minute: 30},
+			args:           args{ts: time.Date(2024, time.March, 16, 8, 0, 0, 0, time.UTC)},
+			expectedResult: +1,
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+
+			value := test.daytime.CompareTime(test.args.ts)
+			assert.EqualValues(tt, test.expectedResult, value)
+		})
+	}
+}
+
+func TestIsDaytime(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		sunrise DayTime
+		sunset  DayTime
+	}
+	tests := []struct {
+		name           string
+		daytime        *DayTime
+		args           args
+		expectedResult bool
+	}{
+		{
+			name:           "Checking the day",
+			daytime:        &DayTime{hour: 12},
+			args:           args{sunrise: DayTime{hour: 6, minute: 30}, sunset: DayTime{hour: 20}},
+			expectedResult: true,
+		},
+		{
+			name:           "Checking the sunrise",
+			daytime:        &DayTime{hour: 6, minute: 30},
+			args:           args{sunrise: DayTime{hour: 6, minute: 30}, sunset: DayTime{hour: 20}},
+			expectedResult: true,
+		},
+		{
+			name:           "Checking the sunset",
+			daytime:        &DayTime{hour: 20},
+			args:           args{sunrise: DayTime{hour: 6, minute: 30}, sunset: DayTime{hour: 20}},
+			expectedResult: false,
+		},
+		{
+			name:           "Checking the night",
+			daytime:        &DayTime{hour: 2},
+			args:           args{sunrise: DayTime{hour: 6, minute: 30}, sunset: DayTime{hour: 20}},
+			expectedResult: false,
+		},
+		{
+			name:           "Checking the wrapped day after midnight",
+			daytime:        &DayTime{hour: 1},
+			args:           args{sunrise: DayTime{hour: 4}, sunset: DayTime{hour: 2}},
+			expectedResult: true,
+		},
+		{
+			name:           "Checking the night of the wrapped day",
+			daytime:        &DayTime{hour: 3},
+			args:           args{sunrise: DayTime{hour: 4}, sunset: DayTime{hour: 2}},
+			expectedResult: false,
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+
+			value := test.daytime.IsDaytime(test.args.sunrise, test.args.sunset)
+			assert.EqualValues(tt, test.expectedResult, value)
+		})
+	}
+}
+
+func TestIsBetweenHours(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		startHour int
+		endHour   int
+	}
+	tests := []struct {
+		name           string
+		daytime        *DayTime
+		args           args
+		expectedResult bool
+	}{
+		{
+			name:           "Checking the value within the hours",
+			daytime:        &DayTime{hour: 17, minute: 59, second: 59},
+			args:           args{startHour: 9, endHour: 18},
+			expectedResult: true,
+		},
+		{
+			name:           "Checking the value at the end hour",
+			daytime:        &DayTime{hour: 18},
+			args:           args{startHour: 9, endHour: 18},
+			expectedResult: false,
+		},
+		{
+			name:           "Checking the wrapped hours",
+			daytime:        &DayTime{hour: 23},
+			args:           args{startHour: 22, endHour: 6},
+			expectedResult: true,
+		},
+		{
+			name:           "Checking the value outside the wrapped hours",
+			daytime:        &DayTime{hour: 12},
+			args:           args{startHour: 22, endHour: 6},
+			expectedResult: false,
+		},
+		{
+			name:           "Checking the end of the day",
+			daytime:        &DayTime{hour: 23, minute: 30},
+			args:           args{startHour: 18, endHour: 24},
+			expectedResult: true,
+		},
+		{
+			name:           "Checking the processing of out of range hours",
+			daytime:        &DayTime{hour: 9},
+			args:           args{startHour: -3, endHour: 30},
+			expectedResult: false,
+		},
+		{
+			name:           "Checking the processing of the start hour 24",
+			daytime:        &DayTime{hour: 9},
+			args:           args{startHour: 24, endHour: 12},
+			expectedResult: false,
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+
+			value := test.daytime.IsBetweenHours(test.args.startHour, test.args.endHour)
+			assert.EqualValues(tt, test.expectedResult, value)
+		})
+	}
+}
+
+func TestMatchesTime(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		ts        time.Time
+		tolerance time.Duration
+	}
+	tests := []struct {
+		name           string
+		daytime        *DayTime
+		args           args
+		expectedResult bool
+	}{
+		{
+			name:    "Checking an exact match",
+			daytime: &DayTime{hour: 9, minute: 30},
+			args: args{
+				ts:        time.Date(2024, 3, 10, 9, 30, 0, 0, time.UTC),
+				tolerance: 0,
+			},
+			expectedResult: true,
+		},
+		{
+			name:    "Checking a match within the tolerance",
+			daytime: &DayTime{hour: 9, minute: 30},
+			args: args{
+				ts:        time.Date(2024, 3, 10, 9, 25, 0, 0, time.UTC),
+				tolerance: 5 * time.Minute,
+			},
+			expectedResult: true,
+		},
+		{
+			name:    "Checking a time just outside the tolerance",
+			daytime: &DayTime{hour: 9, minute: 30},
+			args: args{
+				ts:        time.Date(2024, 3, 10, 9, 35, 1, 0, time.UTC),
+				tolerance: 5 * time.Minute,
+			},
+			expectedResult: false,
+		},
+		{
+			name:    "Checking a match around midnight",
+			daytime: &DayTime{hour: 23, minute: 59},
+			args: args{
+				ts:        time.Date(2024, 3, 10, 0, 1, 0, 0, time.UTC),
+				tolerance: 2 * time.Minute,
+			},
+			expectedResult: true,
+		},
+		{
+			name:    "Checking a time just outside the tolerance around midnight",
+			daytime: &DayTime{hour: 23, minute: 59},
+			args: args{
+				ts:        time.Date(2024, 3, 10, 0, 1, 1, 0, time.UTC),
+				tolerance: 2 * time.Minute,
+			},
+			expectedResult: false,
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+
+			value := test.daytime.MatchesTime(test.args.ts, test.args.tolerance)
+			assert.EqualValues(tt, test.expectedResult, value)
+		})
+	}
+}
+
+func TestCompare(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		other *DayTime
+	}
+	tests := []struct {
+		name           string
+		daytime        *DayTime
+		args           args
+		expectedResult int
+	}{
+		{
+			name: "Checking the less value",
+			daytime: &DayTime{
+				hour:   1,
+				minute: 2,
+				second: 3,
+			},
+			args: args{
+				other: &DayTime{
+					hour:   1,
+					minute: 3,
+					second: 0,
+				},
+			},
+			expectedResult: -1,
+		},
+		{
+			name: "Checking equal values",
+			daytime: &DayTime{
+				hour:   1,
+				minute: 2,
+				second: 3,
+			},
+			args: args{
+				other: &DayTime{
+					hour:   1,
+					minute: 2,
+					second: 3,
+				},
+			},
+			expectedResult: 0,
+		},
+		{
+			name: "Checking the greater value",
+			daytime: &DayTime{
+				hour:   23,
+				minute: 0,
+				second: 0,
+			},
+			args: args{
+				other: &DayTime{
+					hour:   1,
+					minute: 2,
+					second: 3,
+				},
+			},
+			expectedResult: +1,
+		},
+		{
+			name:    "Checking to process nil receiver",
+			daytime: nil,
+			args: args{
+				other: &DayTime{
+					hour:   0,
+					minute: 0,
+					second: 1,
+				},
+			},
+			expectedResult: -1,
+		},
+		{
+			name: "Checking to process nil argument",
+			daytime: &DayTime{
+				hour:   0,
+				minute: 0,
+				second: 1,
+			},
+			args: args{
+				other: nil,
+			},
+			expectedResult: +1,
+		},
+		{
+			name:    "Checking to process nil and midnight",
+			daytime: nil,
+			args: args{
+				other: &DayTime{},
+			},
+			expectedResult: 0,
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+
+			value := test.daytime.Compare(test.args.other)
+			assert.EqualValues(tt, test.expectedResult, value)
+		})
+	}
+}
+
+func TestBetween(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		start     *DayTime
+		end       *DayTime
+		inclusive bool
+	}
+	tests := []struct {
+		name           string
+		daytime        *DayTime
+		args           args
+		expectedResult bool
+	}{
+		{
+			name:    "Checking the value inside the interval",
+			daytime: &DayTime{hour: 12},
+			args: args{
+				start: &DayTime{hour: 9},
+				end:   &DayTime{hour: 17},
+			},
+			expectedResult: true,
+		},
+		{
+			name:    "Checking the value outside the interval",
+			daytime: &DayTime{hour: 18},
+			args: args{
+				start: &DayTime{hour: 9},
+				end:   &DayTime{hour: 17},
+			},
+			expectedResult: false,
+		},
+		{
+			name:    "Checking the start with the exclusive flag",
+			daytime: &DayTime{hour: 9},
+			args: args{
+				start: &DayTime{hour: 9},
+				end:   &DayTime{hour: 17},
+			},
+			expectedResult: false,
+		},
+		{
+			name:    "Checking the start with the inclusive flag",
+			daytime: &DayTime{hour: 9},
+			args: args{
+				start:     &DayTime{hour: 9},
+				end:       &DayTime{hour: 17},
+				inclusive: true,
+			},
+			expectedResult: true,
+		},
+		{
+			name:    "Checking the end with the inclusive flag",
+			daytime: &DayTime{hour: 17},
+			args: args{
+				start:     &DayTime{hour: 9},
+				end:       &DayTime{hour: 17},
+				inclusive: true,
+			},
+			expectedResult: true,
+		},
+		{
+			name:    "Checking the value before midnight in the wrapping interval",
+			daytime: &DayTime{hour: 23},
+			args: args{
+				start: &DayTime{hour: 22},
+				end:   &DayTime{hour: 2},
+			},
+			expectedResult: true,
+		},
+		{
+			name:    "Checking the value after midnight in the wrapping interval",
+			daytime: &DayTime{hour: 1},
+			args: args{
+				start: &DayTime{hour: 22},
+				end:   &DayTime{hour: 2},
+			},
+			expectedResult: true,
+		},
+		{
+			name:    "Checking the value outside the wrapping interval",
+			daytime: &DayTime{hour: 12},
+			args: args{
+				start: &DayTime{hour: 22},
+				end:   &DayTime{hour: 2},
+			},
+			expectedResult: false,
+		},
+		{
+			name:    "Checking the end of the wrapping interval with the exclusive flag",
+			daytime: &DayTime{hour: 2},
+			args: args{
+				start: &DayTime{hour: 22},
+				end:   &DayTime{hour: 2},
+			},
+			expectedResult: false,
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+
+			value := test.daytime.Between(test.args.start, test.args.end, test.args.inclusive)
+			assert.EqualValues(tt, test.expectedResult, value)
+		})
+	}
+}
+
+func TestClamp(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		lower *DayTime
+		upper *DayTime
+	}
+	tests := []struct {
+		name           string
+		daytime        *DayTime
+		args           args
+		expectedResult DayTime
+	}{
+		{
+			name:    "Checking the value below the range",
+			daytime: &DayTime{hour: 7, minute: 30},
+			args: args{
+				lower: &DayTime{hour: 9},
+				upper: &DayTime{hour: 17},
+			},
+			expectedResult: DayTime{hour: 9},
+		},
+		{
+			name:    "Checking the value inside the range",
+			daytime: &DayTime{hour: 12, second: 1},
+			args: args{
+				lower: &DayTime{hour: 9},
+				upper: &DayTime{hour: 17},
+			},
+			expectedResult: DayTime{hour: 12, second: 1},
+		},
+		{
+			name:    "Checking the value above the range",
+			daytime: &DayTime{hour: 17, nanosecond: 1},
+			args: args{
+				lower: &DayTime{hour: 9},
+				upper: &DayTime{hour: 17},
+			},
+			expectedResult: DayTime{hour: 17},
+		},
+		{
+			name:    "Checking the processing of the reversed range",
+			daytime: &DayTime{hour: 12},
+			args: args{
+				lower: &DayTime{hour: 17},
+				upper: &DayTime{hour: 9},
+			},
+			expectedResult: DayTime{hour: 17},
+		},
+		{
+			name:    "Checking the processing of the reversed range after lower",
+			daytime: &DayTime{hour: 18},
+			args: args{
+				lower: &DayTime{hour: 17},
+				upper: &DayTime{hour: 9},
+			},
+			expectedResult: DayTime{hour: 9},
+		},
+		{
+			name:    "Checking to process nil",
+			daytime: nil,
+			args: args{
+				lower: &DayTime{hour: 9},
+				upper: &DayTime{hour: 17},
+			},
+			expectedResult: DayTime{hour: 9},
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+
+			value := test.daytime.Clamp(test.args.lower, test.args.upper)
+			assert.EqualValues(tt, test.expectedResult, value)
+		})
+	}
+}
+
+func TestMinAndMax(t *testing.T) {
+	t.Parallel()
+
+	type expectedResult struct {
+		min DayTime
+		max DayTime
+		ok  bool
+	}
+	tests := []struct {
+		name           string
+		times          []DayTime
+		expectedResult expectedResult
+	}{
+		{
+			name: "Checking standard work",
+			times: []DayTime{
+				{hour: 12},
+				{hour: 9, minute: 30},
+				{hour: 17, second: 1},
+				{hour: 9, minute: 30, nanosecond: 1},
+			},
+			expectedResult: expectedResult{
+				min: DayTime{hour: 9, minute: 30},
+				max: DayTime{hour: 17, second: 1},
+				ok:  true,
+			},
+		},
+		{
+			name: "Checking the processing of a single value",
+			times: []DayTime{
+				{hour: 12},
+			},
+			expectedResult: expectedResult{
+				min: DayTime{hour: 12},
+				max: DayTime{hour: 12},
+				ok:  true,
+			},
+		},
+		{
+			name:           "Checking the processing of no values",
+			times:          nil,
+			expectedResult: expectedResult{},
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+
+			value, ok := Min(test.times...)
+			assert.EqualValues(tt, test.expectedResult.min, value)
+			assert.EqualValues(tt, test.expectedResult.ok, ok)
+
+			value, ok = Max(test.times...)
+			assert.EqualValues(tt, test.expectedResult.max, value)
+			assert.EqualValues(tt, test.expectedResult.ok, ok)
+		})
+	}
+}
+
+func TestDayTimesSort(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		times          DayTimes
+		expectedResult DayTimes
+	}{
+		{
+			name: "Checking standard work",
+			times: DayTimes{
+				{hour: 17},
+				{hour: 9, minute: 30},
+				{hour: 12},
+				{hour: 9, minute: 30},
+				{},
+				{hour: 9, minute: 30, nanosecond: 1},
+			},
+			expectedResult: DayTimes{
+				{},
+				{hour: 9, minute: 30},
+				{hour: 9, minute: 30},
+				{hour: 9, minute: 30, nanosecond: 1},
+				{hour: 12},
+				{hour: 17},
+			},
+		},
+		{
+			name:           "Checking the processing of an empty slice",
+			times:          DayTimes{},
+			expectedResult: DayTimes{},
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+
+			test.times.Sort()
+			assert.EqualValues(tt, test.expectedResult, test.times)
+		})
+	}
+}
+
+func TestAdd(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		d time.Duration
+	}
+	tests := []struct {
+		name           string
+		daytime        *DayTime
+		args           args
+		expectedResult DayTime
+	}{
+		{
+			name: "Checking standard work",
+			daytime: &DayTime{
+				hour:   1,
+				minute: 2,
+				second: 3,
+			},
+			args: args{
+				d: time.Hour + time.Second,
+			},
+			expectedResult: DayTime{
+				hour:   2,
+				minute: 2,
+				second: 4,
+			},
+		},
+		{
+			name: "Checking the forward wrap",
+			daytime: &DayTime{
+				hour:   23,
+				minute: 30,
+			},
+			args: args{
+				d: time.Hour,
+			},
+			expectedResult: DayTime{
+				minute: 30,
+			},
+		},
+		{
+			name: "Checking the backward wrap",
+			daytime: &DayTime{
+				minute: 30,
+			},
+			args: args{
+				d: -time.Hour,
+			},
+			expectedResult: DayTime{
+				hour:   23,
+				minute: 30,
+			},
+		},
+		{
+			name: "Checking the fractional seconds",
+			daytime: &DayTime{
+				hour:       23,
+				minute:     59,
+				second:     59,
+				nanosecond: 500000000,
+			},
+			args: args{
+				d: time.Second,
+			},
+			expectedResult: DayTime{
+				nanosecond: 500000000,
+			},
+		},
+		{
+			name: "Checking the multi-day duration",
+			daytime: &DayTime{
+				hour: 10,
+			},
+			args: args{
+				d: 3*Day + 2*time.Hour,
+			},
+			expectedResult: DayTime{
+				hour: 12,
+			},
+		},
+		{
+			name:    "Checking to process nil",
+			daytime: nil,
+			args: args{
+				d: time.Minute,
+			},
+			expectedResult: DayTime{
+				minute: 1,
+			},
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+
+			value := test.daytime.Add(test.args.d)
+			assert.EqualValues(tt, test.expectedResult, value)
+		})
+	}
+}
+
+func TestAddWithOverflow(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		d time.Duration
+	}
+	type expectedResult struct {
+		daytime DayTime
+		days    int
+	}
+	tests := []struct {
+		name           string
+		daytime        *DayTime
+		args           args
+		expectedResult expectedResult
+	}{
+		{
+			name: "Checking the addition within the day",
+			daytime: &DayTime{
+				hour: 1,
+			},
+			args: args{
+				d: time.Hour,
+			},
+			expectedResult: expectedResult{
+				daytime: DayTime{
+					hour: 2,
+				},
+				days: 0,
+			},
+		},
+		{
+			name: "Checking the positive overflow",
+			daytime: &DayTime{
+				hour: 23,
+			},
+			args: args{
+				d: 26 * time.Hour,
+			},
+			expectedResult: expectedResult{
+				daytime: DayTime{
+					hour: 1,
+				},
+				days: 2,
+			},
+		},
+		{
+			name: "Checking the negative underflow",
+			daytime: &DayTime{
+				hour: 1,
+			},
+			args: args{
+				d: -2 * time.Hour,
+			},
+			expectedResult: expectedResult{
+				daytime: DayTime{
+					hour: 23,
+				},
+				days: -1,
+			},
+		},
+		{
+			name: "Checking the exact multiple of days",
+			daytime: &DayTime{
+				hour: 12,
+			},
+			args: args{
+				d: -2 * Day,
+			},
+			expectedResult: expectedResult{
+				daytime: DayTime{
+					hour: 12,
+				},
+				days: -2,
+			},
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+
+			daytime, days := test.daytime.AddWithOverflow(test.args.d)
+			assert.EqualValues(tt, test.expectedResult.daytime, daytime)
+			assert.EqualValues(tt, test.expectedResult.days, days)
+		})
+	}
+}
+
+func TestSub(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		other *DayTime
+	}
+	tests := []struct {
+		name           string
+		daytime        *DayTime
+		args           args
+		expectedResult time.Duration
+	}{
+		{
+			name: "Checking the positive difference",
+			daytime: &DayTime{
+				hour:   17,
+				minute: 30,
+			},
+			args: args{
+				other: &DayTime{
+					hour: 9,
+				},
+			},
+			expectedResult: 8*time.Hour + 30*time.Minute,
+		},
+		{
+			name: "Checking the negative difference",
+			daytime: &DayTime{
+				hour: 9,
+			},
+			args: args{
+				other: &DayTime{
+					hour:   9,
+					second: 1,
+				},
+			},
+			expectedResult: -time.Second,
+		},
+		{
+			name: "Checking the zero difference",
+			daytime: &DayTime{
+				hour:   1,
+				minute: 2,
+				second: 3,
+			},
+			args: args{
+				other: &DayTime{
+					hour:   1,
+					minute: 2,
+					second: 3,
+				},
+			},
+			expectedResult: 0,
+		},
+		{
+			name: "Checking to process nil",
+			daytime: &DayTime{
+				hour: 1,
+			},
+			args: args{
+				other: nil,
+			},
+			expectedResult: time.Hour,
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+
+			value := test.daytime.Sub(test.args.other)
+			assert.EqualValues(tt, test.expectedResult, value)
+		})
+	}
+}
+
+func TestWrapDistance(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		other *DayTime
+	}
+	tests := []struct {
+		name           string
+		daytime        *DayTime
+		args           args
+		expectedResult time.Duration
+	}{
+		{
+			name:           "Checking standard work",
+			daytime:        &DayTime{hour: 9},
+			args:           args{other: &DayTime{hour: 17, minute: 30}},
+			expectedResult: 8*time.Hour + 30*time.Minute,
+		},
+		{
+			name:           "Checking the processing of wrapping",
+			daytime:        &DayTime{hour: 23},
+			args:           args{other: &DayTime{hour: 1}},
+			expectedResult: 2 * time.Hour,
+		},
+		{
+			name:           "Checking the identical values",
+			daytime:        &DayTime{hour: 9, minute: 30},
+			args:           args{other: &DayTime{hour: 9, minute: 30}},
+			expectedResult: 0,
+		},
+		{
+			name:           "Checking the end of the day",
+			daytime:        &DayTime{hour: 24},
+			args:           args{other: &DayTime{}},
+			expectedResult: 0,
+		},
+		{
+			name:           "Checking to process nil",
+			daytime:        nil,
+			args:           args{other: &DayTime{hour: 22}},
+			expectedResult: 2 * time.Hour,
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+
+			value := test.daytime.WrapDistance(test.args.other)
+			assert.EqualValues(tt, test.expectedResult, value)
+		})
+	}
+}
+
+func TestDuration(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		daytime        *DayTime
+		expectedResult time.Duration
+	}{
+		{
+			name: "Checking standard work",
+			daytime: &DayTime{
+				hour:   1,
+				minute: 2,
+				second: 3,
+			},
+			expectedResult: time.Hour + 2*time.Minute + 3*time.Second,
+		},
+		{
+			name:           "Checking to process midnight",
+			daytime:        &DayTime{},
+			expectedResult: 0,
+		},
+		{
+			name:           "Checking to process nil",
+			daytime:        nil,
+			expectedResult: 0,
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+
+			value := test.daytime.Duration()
+			assert.EqualValues(tt, test.expectedResult, value)
+		})
+	}
+}
+
+func TestProtoSeconds(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		seconds int32
+	}
+	type expectedResult struct {
+		daytime DayTime
+		err     error
+	}
+	tests := []struct {
+		name           string
+		args           args
+		expectedResult expectedResult
+	}{
+		{
+			name: "Checking to process zero",
+			args: args{
+				seconds: 0,
+			},
+			expectedResult: expectedResult{
+				daytime: DayTime{},
+				err:     nil,
+			},
+		},
+		{
+			name: "Checking to process the last second of the day",
+			args: args{
+				seconds: 86399,
+			},
+			expectedResult: expectedResult{
+				daytime: DayTime{hour: 23, minute: 59, second: 59},
+				err:     nil,
+			},
+		},
+		{
+			name: "Checking to process a whole day",
+			args: args{
+				seconds: 86400,
+			},
+			expectedResult: expectedResult{
+				daytime: DayTime{},
+				err:     ErrInvalid,
+			},
+		},
+		{
+			name: "Checking to process a negative value",
+			args: args{
+				seconds: -1,
+			},
+			expectedResult: expectedResult{
+				daytime: DayTime{},
+				err:     ErrInvalid,
+			},
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+
+			daytime, err := FromProtoSeconds(test.args.seconds)
+			assert.EqualValues(tt, test.expectedResult.daytime, daytime)
+			assert.ErrorIs(tt, err, test.expectedResult.err)
+			if err == nil {
+				assert.EqualValues(tt, test.args.seconds, daytime.ToProtoSeconds())
+			}
+		})
+	}
+}
+
+func TestTotalSecondsAndMinutes(t *testing.T) {
+	t.Parallel()
+
+	type expectedResult struct {
+		seconds int
+		minutes int
+	}
+	tests := []struct {
+		name           string
+		daytime        *DayTime
+		expectedResult expectedResult
+	}{
+		{
+			name: "Checking standard work",
+			daytime: &DayTime{
+				hour:   1,
+				minute: 2,
+				second: 3,
+			},
+			expectedResult: expectedResult{
+				seconds: 3723,
+				minutes: 62,
+			},
+		},
+		{
+			name: "Checking the processing of the end of the day",
+			daytime: &DayTime{
+				hour:       23,
+				minute:     59,
+				second:     59,
+				nanosecond: 999999999,
+			},
+			expectedResult: expectedResult{
+				seconds: 86399,
+				minutes: 1439,
+			},
+		},
+		{
+			name:           "Checking to process nil",
+			daytime:        nil,
+			expectedResult: expectedResult{},
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+
+			assert.EqualValues(tt, test.expectedResult.seconds, test.daytime.TotalSeconds())
+			assert.EqualValues(tt, test.expectedResult.minutes, test.daytime.TotalMinutes())
+		})
+	}
+}
+
+func TestTruncate(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		d time.Duration
+	}
+	tests := []struct {
+		name           string
+		daytime        *DayTime
+		args           args
+		expectedResult DayTime
+	}{
+		{
+			name:           "Checking standard work",
+			daytime:        &DayTime{hour: 9, minute: 7},
+			args:           args{d: 15 * time.Minute},
+			expectedResult: DayTime{hour: 9},
+		},
+		{
+			name:           "Checking the processing of 5 minutes",
+			daytime:        &DayTime{hour: 9, minute: 14, second: 59},
+			args:           args{d: 5 * time.Minute},
+			expectedResult: DayTime{hour: 9, minute: 10},
+		},
+		{
+			name:           "Checking the processing of an hour",
+			daytime:        &DayTime{hour: 23, minute: 59, second: 59, nanosecond: 999},
+			args:           args{d: time.Hour},
+			expectedResult: DayTime{hour: 23},
+		},
+		{
+			name:           "Checking the processing of an exact boundary",
+			daytime:        &DayTime{hour: 9, minute: 15},
+			args:           args{d: 15 * time.Minute},
+			expectedResult: DayTime{hour: 9, minute: 15},
+		},
+		{
+			name:           "Checking the processing of a non-positive duration",
+			daytime:        &DayTime{hour: 9, minute: 7},
+			args:           args{d: 0},
+			expectedResult: DayTime{hour: 9, minute: 7},
+		},
+		{
+			name:           "Checking to process nil",
+			daytime:        nil,
+			args:           args{d: time.Hour},
+			expectedResult: DayTime{},
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+
+			value := test.daytime.Truncate(test.args.d)
+			assert.EqualValues(tt, test.expectedResult, value)
+		})
+	}
+}
+
+func TestRound(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		d time.Duration
+	}
+	tests := []struct {
+		name           string
+		daytime        *DayTime
+		args           args
+		expectedResult DayTime
+	}{
+		{
+			name:           "Checking the processing of rounding down",
+			daytime:        &DayTime{hour: 9, minute: 7},
+			args:           args{d: 15 * time.Minute},
+			expectedResult: DayTime{hour: 9},
+		},
+		{
+			name:           "Checking the processing of rounding up",
+			daytime:        &DayTime{hour: 9, minute: 8},
+			args:           args{d: 15 * time.Minute},
+			expectedResult: DayTime{hour: 9, minute: 15},
+		},
+		{
+			name:           "Checking the processing of a halfway value",
+			daytime:        &DayTime{hour: 9, minute: 30},
+			args:           args{d: time.Hour},
+			expectedResult: DayTime{hour: 10},
+		},
+		{
+			name:           "Checking the processing of an exact boundary",
+			daytime:        &DayTime{hour: 9, minute: 15},
+			args:           args{d: 15 * time.Minute},
+			expectedResult: DayTime{hour: 9, minute: 15},
+		},
+		{
+			name:           "Checking the processing of midnight wrapping",
+			daytime:        &DayTime{hour: 23, minute: 59},
+			args:           args{d: time.Hour},
+			expectedResult: DayTime{},
+		},
+		{
+			name:           "Checking the processing of a non-positive duration",
+			daytime:        &DayTime{hour: 9, minute: 7},
+			args:           args{d: -time.Minute},
+			expectedResult: DayTime{hour: 9, minute: 7},
+		},
+		{
+			name:           "Checking to process nil",
+			daytime:        nil,
+			args:           args{d: time.Hour},
+			expectedResult: DayTime{},
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+
+			value := test.daytime.Round(test.args.d)
+			assert.EqualValues(tt, test.expectedResult, value)
+		})
+	}
+}
+
+func TestTime(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	year := now.Year()
+	month := now.Month()
+	day := now.Day()
+	location := now.Location()
+
+	tests := []struct {
+		name           string
+		daytime        *DayTime
+		expectedResult time.Time
+	}{
+		{
+			name: "Checking standard work",
+			daytime: &DayTime{
+				hour:   1,
+				minute: 2,
+				second: 3,
+			},
+			expectedResult: time.Date(
+				year,
+				month,
+				day,
+				1,
+				2,
+				3,
+				0,
+				location,
+			),
+		},
+		{
+			name: "Checking the value with nanoseconds",
+			daytime: &DayTime{
+				hour:       1,
+				minute:     2,
+				second:     3,
+				nanosecond: 250000000,
+			},
+			expectedResult: time.Date(
+				year,
+				month,
+				day,
+				1,
+				2,
+				3,
+				250000000,
+				location,
+			),
+		},
+		{
+			name:    "Checking the nil value",
+			daytime: nil,
+			expectedResult: time.Date(
+				year,
+				month,
+				day,
+				0,
+				0,
+				0,
+				0,
+				location,
+			),
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+
+			value := test.daytime.Time()
+			assert.EqualValues(tt, test.expectedResult, value)
+		})
+	}
+}
+
+func TestTimeInLocation(t *testing.T) {
+	t.Parallel()
+
+	moscow := time.FixedZone("MSK", 3*60*60)
+
+	type args struct {
+		loc *time.Location
+	}
+	tests := []struct {
+		name    string
+		daytime *DayTime
+		args    args
+	}{
+		{
+			name: "Checking UTC",
+			daytime: &DayTime{
+				hour:   1,
+				minute: 2,
+				second: 3,
+			},
+			args: args{
+				loc: time.UTC,
+			},
+		},
+		{
+			name: "Checking the fixed offset location",
+			daytime: &DayTime{
+				hour:   1,
+				minute: 2,
+				second: 3,
+			},
+			args: args{
+				loc: moscow,
+			},
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+
+			year, month, day := time.Now().In(test.args.loc).Date()
+			expectedResult := time.Date(year, month, day, 1, 2, 3, 0, test.args.loc)
+
+			value := test.daytime.TimeInLocation(test.args.loc)
+			assert.EqualValues(tt, expectedResult, value)
+			assert.EqualValues(tt, test.args.loc, value.Location())
+		})
+	}
+
+	utc := (&DayTime{hour: 12}).TimeInLocation(time.UTC)
+	msk := (&DayTime{hour: 12}).TimeInLocation(moscow)
+	if utc.YearDay() == msk.YearDay() {
+		assert.EqualValues(t, 3*time.Hour, utc.Sub(msk))
+	}
+}
+
+func TestTimeUTC(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		daytime *DayTime
+	}{
+		{
+			name: "Checking standard work",
+			daytime: &DayTime{
+				hour:   1,
+				minute: 2,
+				second: 3,
+			},
+		},
+		{
+			name:    "Checking to process nil",
+			daytime: nil,
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+
+			value := test.daytime.TimeUTC()
+			year, month, day := time.Now().UTC().Date()
+			hour, minute, second := test.daytime.Clock()
+			expectedResult := time.Date(year, month, day, hour, minute, second, 0, time.UTC)
+
+			assert.EqualValues(tt, expectedResult, value)
+			assert.EqualValues(tt, time.UTC, value.Location())
+		})
+	}
+}
+
+func TestTimeOn(t *testing.T) {
+	t.Parallel()
+
+	moscow := time.FixedZone("MSK", 3*60*60)
+
+	type args struct {
+		date time.Time
+	}
+	tests := []struct {
+		name           string
+		daytime        *DayTime
+		args           args
+		expectedResult time.Time
+	}{
+		{
+			name: "Checking standard work",
+			daytime: &DayTime{
+				hour:   1,
+				minute: 2,
+				second: 3,
+			},
+			args: args{
+				date: time.Date(2024, time.March, 15, 23, 59, 59, 999, moscow),
+			},
+			expectedResult: time.Date(2024, time.March, 15, 1, 2, 3, 0, moscow),
+		},
+		{
+			name:    "Checking the nil value",
+			daytime: nil,
+			args: args{
+				date: time.Date(2024, time.March, 15, 12, 0, 0, 0, moscow),
+			},
+			expectedResult: time.Date(2024, time.March, 15, 0, 0, 0, 0, moscow),
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+
+			value := test.daytime.TimeOn(test.args.date)
+			assert.EqualValues(tt, test.expectedResult, value)
+		})
+	}
+}
+
+func TestInTheNearFuture(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	hour := now.Hour()
+	minute := now.Minute()
+	second := now.Second()
+	nanosecond := now.Nanosecond()
+	location := now.Location()
+	nextHour := now.Add(time.Hour)
+	nextDay := now.Add(Day)
+	time.Sleep(time.Millisecond)
+
+	tests := []struct {
+		name           string
+		daytime        *DayTime
+		expectedResult time.Time
+	}{
+		{
+			name: "Checking to get the current day",
+			daytime: &DayTime{
+				hour:   nextHour.Hour(),
+				minute: minute,
+				second: second,
+			},
+			expectedResult: time.Date(
+				now.Year(),
+				now.Month(),
+				now.Day(),
+				nextHour.Hour(),
+				minute,
+				second,
+				nanosecond,
+				location,
+			),
+		},
+		{
+			name: "Checking to get the next day",
+			daytime: &DayTime{
+				hour:   hour,
+				minute: minute,
+				second: second,
+			},
+			expectedResult: time.Date(
+				nextDay.Year(),
+				nextDay.Month(),
+				nextDay.Day(),
+				hour,
+				minute,
+				second,
+				nanosecond,
+				location,
+			),
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+
+			value := test.daytime.InTheNearFuture()
+			assert.EqualValues(tt, test.expectedResult.Year(), value.Year())
+			assert.EqualValues(tt, test.expectedResult.Month(), value.Month())
+			assert.EqualValues(tt, test.expectedResult.Day(), value.Day())
+			assert.EqualValues(tt, test.expectedResult.Hour(), value.Hour())
+			assert.EqualValues(tt, test.expectedResult.Minute(), value.Minute())
+			assert.EqualValues(tt, test.expectedResult.Second(), value.Second())
+		})
+	}
+}
+
+func TestInTheRecentPast(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	hour := now.Hour()
+	minute := now.Minute()
+	second := now.Second()
+	nanosecond := now.Nanosecond()
+	nextHour := now.Add(time.Hour)
+	previousDay := now.Add(-Day)
+	location := now.Location()
+	time.Sleep(time.Millisecond)
+
+	tests := []struct {
+		name           string
+		daytime        *DayTime
+		expectedResult time.Time
+	}{
+		{
+			name: "Checking to get the current day",
+			daytime: &DayTime{
+				hour:   hour,
+				minute: minute,
+				second: second,
+			},
+			expectedResult: time.Date(
+				now.Year(),
+				now.Month(),
+				now.Day(),
+				hour,
+				minute,
+				second,
+				nanosecond,
+				location,
+			),
+		},
+		{
+			name: "Checking to get the previous day",
+			daytime: &DayTime{
+				hour:   nextHour.Hour(),
+				minute: minute,
+				second: second,
+			},
+			expectedResult: time.Date(
+				now.Year(),
+				now.Month(),
+				previousDay.Day(),
+				nextHour.Hour(),
+				minute,
+				second,
+				nanosecond,
+				location,
+			),
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+
+			value := test.daytime.InTheRecentPast()
+			assert.EqualValues(tt, test.expectedResult.Year(), value.Year())
+			assert.EqualValues(tt, test.expectedResult.Month(), value.Month())
+			assert.EqualValues(tt, test.expectedResult.Day(), value.Day())
+			assert.EqualValues(tt, test.expectedResult.Hour(), value.Hour())
+			assert.EqualValues(tt, test.expectedResult.Minute(), value.Minute())
+			assert.EqualValues(tt, test.expectedResult.Second(), value.Second())
+		})
+	}
+}
+
+func TestInTheNearFutureFrom(t *testing.T) {
+	t.Parallel()
+
+	ref := time.Date(2024, time.March, 15, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		daytime        *DayTime
+		expectedResult time.Time
+	}{
+		{
+			name: "Checking to get the current day",
+			daytime: &DayTime{
+				hour: 13,
+			},
+			expectedResult: time.Date(2024, time.March, 15, 13, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "Checking to get the next day",
+			daytime: &DayTime{
+				hour: 11,
+			},
+			expectedResult: time.Date(2024, time.March, 16, 11, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "Checking the reference itself",
+			daytime: &DayTime{
+				hour: 12,
+			},
+			expectedResult: ref,
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+
+			value := test.daytime.InTheNearFutureFrom(ref)
+			assert.EqualValues(tt, test.expectedResult, value)
+		})
+	}
+}
+
+func TestInTheRecentPastFrom(t *testing.T) {
+	t.Parallel()
+
+	ref := time.Date(2024, time.March, 15, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		daytime        *DayTime
+		expectedResult time.Time
+	}{
+		{
+			name: "Checking to get the current day",
+			daytime: &DayTime{
+				hour: 11,
+			},
+			expectedResult: time.Date(2024, time.March, 15, 11, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "Checking to get the previous day",
+			daytime: &DayTime{
+				hour: 13,
+			},
+			expectedResult: time.Date(2024, time.March, 14, 13, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "Checking the reference itself",
+			daytime: &DayTime{
+				hour: 12,
+			},
+			expectedResult: ref,
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+
+			value := test.daytime.InTheRecentPastFrom(ref)
+			assert.EqualValues(tt, test.expectedResult, value)
+		})
+	}
+}
+
+func TestDurationUntilAndSince(t *testing.T) {
+	nowFunc = func() time.Time {
+		return time.Date(2024, time.March, 15, 12, 0, 0, 0, time.UTC)
+	}
+	t.Cleanup(func() {
+		nowFunc = time.Now
+	})
+
+	type expectedResult struct {
+		until time.Duration
+		since time.Duration
+	}
+	tests := []struct {
+		name           string
+		daytime        *DayTime
+		expectedResult expectedResult
+	}{
+		{
+			name: "Checking the later value",
+			daytime: &DayTime{
+				hour:   15,
+				minute: 20,
+			},
+			expectedResult: expectedResult{
+				until: 3*time.Hour + 20*time.Minute,
+				since: 20*time.Hour + 40*time.Minute,
+			},
+		},
+		{
+			name: "Checking the earlier value",
+			daytime: &DayTime{
+				hour: 11,
+			},
+			expectedResult: expectedResult{
+				until: 23 * time.Hour,
+				since: time.Hour,
+			},
+		},
+		{
+			name: "Checking the current value",
+			daytime: &DayTime{
+				hour: 12,
+			},
+			expectedResult: expectedResult{
+				until: 0,
+				since: 0,
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			assert.EqualValues(tt, test.expectedResult.until, test.daytime.DurationUntil())
+			assert.EqualValues(tt, test.expectedResult.since, test.daytime.DurationSince())
+		})
+	}
+}
+
+func TestUntil(t *testing.T) {
+	now := time.Date(2024, time.March, 15, 12, 0, 0, 0, time.UTC)
+	nowFunc = func() time.Time {
+		return now
+	}
+	t.Cleanup(func() {
+		nowFunc = time.Now
+	})
+
+	daytime := &DayTime{hour: 15, minute: 20}
+
+	target, d := daytime.Until()
+	assert.EqualValues(t, time.Date(2024, time.March, 15, 15, 20, 0, 0, time.UTC), target)
+	assert.EqualValues(t, 3*time.Hour+20*time.Minute, d)
+	assert.EqualValues(t, target.Sub(now), d)
+	assert.Positive(t, d)
+}
+
+func TestHumanize(t *testing.T) {
+	nowFunc = func() time.Time {
+		return time.Date(2024, time.March, 15, 12, 0, 0, 0, time.UTC)
+	}
+	t.Cleanup(func() {
+		nowFunc = time.Now
+	})
+
+	tests := []struct {
+		name           string
+		daytime        *DayTime
+		expectedResult string
+	}{
+		{
+			name:           "Checking the near future",
+			daytime:        &DayTime{hour: 14, minute: 5},
+			expectedResult: "in 2h 5m",
+		},
+		{
+			name:           "Checking the recent past",
+			daytime:        &DayTime{hour: 11, minute: 57},
+			expectedResult: "3m ago",
+		},
+		{
+			name:           "Checking whole hours",
+			daytime:        &DayTime{hour: 15},
+			expectedResult: "in 3h",
+		},
+		{
+			name:           "Checking the nearer future",
+			daytime:        &DayTime{hour: 22},
+			expectedResult: "in 10h",
+		},
+		{
+			name:           "Checking the nearer past",
+			daytime:        &DayTime{hour: 1},
+			expectedResult: "11h ago",
+		},
+		{
+			name:           "Checking less than a minute",
+			daytime:        &DayTime{hour: 12, second: 30},
+			expectedResult: "now",
+		},
+		{
+			name:           "Checking the current value",
+			daytime:        &DayTime{hour: 12},
+			expectedResult: "now",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			assert.EqualValues(tt, test.expectedResult, test.daytime.Humanize())
+		})
+	}
+}
+
+func TestTimerUntil(t *testing.T) {
+	nowFunc = func() time.Time {
+		return time.Date(2024, time.March, 15, 11, 59, 59, 995000000, time.UTC)
+	}
+	t.Cleanup(func() {
+		nowFunc = time.Now
+	})
+
+	daytime := &DayTime{hour: 12}
+	timer := daytime.TimerUntil()
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+	case <-time.After(time.Second):
+		assert.Fail(t, "timer has not fired")
+	}
+}
+
+func TestWaitUntil(t *testing.T) {
+	nowFunc = func() time.Time {
+		return time.Date(2024, time.March, 15, 11, 59, 59, 995000000, time.UTC)
+	}
+	t.Cleanup(func() {
+		nowFunc = time.Now
+	})
+
+	t.Run("Checking standard work", func(tt *testing.T) {
+		daytime := &DayTime{hour: 12}
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+
+		err := daytime.WaitUntil(ctx)
+		assert.NoError(tt, err)
+	})
+	t.Run("Checking the processing of a cancelled context", func(tt *testing.T) {
+		daytime := &DayTime{hour: 13}
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		start := time.Now()
+		err := daytime.WaitUntil(ctx)
+		assert.ErrorIs(tt, err, context.Canceled)
+		assert.Less(tt, time.Since(start), time.Second)
+	})
+}
+
+func TestNextOccurrence(t *testing.T) {
+	t.Parallel()
+
+	after := time.Date(2024, time.March, 15, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		daytime        *DayTime
+		expectedResult time.Time
+	}{
+		{
+			name: "Checking to get the current day",
+			daytime: &DayTime{
+				hour: 13,
+			},
+			expectedResult: time.Date(2024, time.March, 15, 13, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "Checking to get the next day",
+			daytime: &DayTime{
+				hour: 11,
+			},
+			expectedResult: time.Date(2024, time.March, 16, 11, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "Checking the anchor equal to the target",
+			daytime: &DayTime{
+				hour: 12,
+			},
+			expectedResult: time.Date(2024, time.March, 16, 12, 0, 0, 0, time.UTC),
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+
+			value := test.daytime.NextOccurrence(after)
+			assert.EqualValues(tt, test.expectedResult, value)
+		})
+	}
+}
+
+func TestPreviousOccurrence(t *testing.T) {
+	t.Parallel()
+
+	before := time.Date(2024, time.March, 15, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		daytime        *DayTime
+		expectedResult time.Time
+	}{
+		{
+			name: "Checking to get the current day",
+			daytime: &DayTime{
+				hour: 11,
+			},
+			expectedResult: time.Date(2024, time.March, 15, 11, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "Checking to get the previous day",
+			daytime: &DayTime{
+				hour: 13,
+			},
+			expectedResult: time.Date(2024, time.March, 14, 13, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "Checking the anchor equal to the target",
+			daytime: &DayTime{
+				hour: 12,
+			},
+			expectedResult: time.Date(2024, time.March, 14, 12, 0, 0, 0, time.UTC),
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+
+			value := test.daytime.PreviousOccurrence(before)
+			assert.EqualValues(tt, test.expectedResult, value)
+		})
+	}
+}
+
+func TestOccurrenceAcrossDST(t *testing.T) {
+	t.Parallel()
+
+	loc, err := time.LoadLocation("America/New_York")
+	assert.NoError(t, err)
+
+	daytime := &DayTime{hour: 9}
+	tests := []struct {
+		name           string
+		occurrence     func(time.Time) time.Time
+		anchor         time.Time
+		expectedResult time.Time
+	}{
+		{
+			name:           "Checking the next occurrence on the spring forward",
+			occurrence:     daytime.NextOccurrence,
+			anchor:         time.Date(2026, time.March, 7, 10, 0, 0, 0, loc),
+			expectedResult: time.Date(2026, time.March, 8, 9, 0, 0, 0, loc),
+		},
+		{
+			name:           "Checking the next occurrence on the fall back",
+			occurrence:     daytime.NextOccurrence,
+			anchor:         time.Date(2026, time.October, 31, 10, 0, 0, 0, loc),
+			expectedResult: time.Date(2026, time.November, 1, 9, 0, 0, 0, loc),
+		},
+		{
+			name:           "Checking the previous occurrence on the spring forward",
+			occurrence:     daytime.PreviousOccurrence,
+			anchor:         time.Date(2026, time.March, 8, 8, 0, 0, 0, loc),
+			expectedResult: time.Date(2026, time.March, 7, 9, 0, 0, 0, loc),
+		},
+		{
+			name:           "Checking the previous occurrence on the fall back",
+			occurrence:     daytime.PreviousOccurrence,
+			anchor:         time.Date(2026, time.November, 1, 8, 0, 0, 0, loc),
+			expectedResult: time.Date(2026, time.October, 31, 9, 0, 0, 0, loc),
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+
+			value := test.occurrence(test.anchor)
+			assert.EqualValues(tt, test.expectedResult, value)
+			assert.EqualValues(tt, 9, value.Hour())
+		})
+	}
+}
+
+func TestMarshalBinary(t *testing.T) {
+	t.Parallel()
+
+	type expectedResult struct {
+		value []byte
+		err   error
+	}
+	tests := []struct {
+		name           string
+		daytime        *DayTime
+		expectedResult expectedResult
+	}{
+		{
+			name: "Checking standard work",
+			daytime: &DayTime{
+				hour:   1,
+				minute: 2,
+				second: 3,
+			},
+			expectedResult: expectedResult{
+				value: []byte{1, 1, 2, 3, 0, 0, 0, 0},
+				err:   nil,
+			},
+		},
+		{
+			name: "Checking to process nanoseconds",
+			daytime: &DayTime{
+				hour:       23,
+				minute:     59,
+				second:     59,
+				nanosecond: 999999999,
+			},
+			expectedResult: expectedResult{
+				value: []byte{1, 23, 59, 59, 0x3b, 0x9a, 0xc9, 0xff},
+				err:   nil,
+			},
+		},
+		{
+			name:    "Checking to process zero",
+			daytime: &DayTime{},
+			expectedResult: expectedResult{
+				value: []byte{1, 0, 0, 0, 0, 0, 0, 0},
+				err:   nil,
+			},
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+
+			value, err := test.daytime.MarshalBinary()
+			assert.EqualValues(tt, test.expectedResult.value, value)
+			assert.EqualValues(tt, test.expectedResult.err, err)
+		})
+	}
+}
+
+func TestUnmarshalBinary(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		data []byte
+	}
+	type expectedResult struct {
+		daytime *DayTime
+		err     error
+	}
+	tests := []struct {
+		name           string
+		daytime        *DayTime
+		args           args
+		expectedResult expectedResult
+	}{
+		{
+			name:    "Checking standard work",
+			daytime: &DayTime{},
+			args: args{
+				data: []byte{1, 1, 2, 3, 0, 0, 0, 0},
+			},
+			expectedResult: expectedResult{
+				daytime: &DayTime{
+					hour:   1,
+					minute: 2,
+					second: 3,
+				},
+				err: nil,
+			},
+		},
+		{
+			name:    "Checking to process nanoseconds",
+			daytime: &DayTime{},
+			args: args{
+				data: []byte{1, 23, 59, 59, 0x3b, 0x9a, 0xc9, 0xff},
+			},
+			expectedResult: expectedResult{
+				daytime: &DayTime{
+					hour:       23,
+					minute:     59,
+					second:     59,
+					nanosecond: 999999999,
 				},
 				err: nil,
 			},
 		},
 		{
-			name: "Checking the processing of an invalid value",
+			name:    "Checking to process the end of the day",
+			daytime: &DayTime{},
 			args: args{
-				value: "",
+				data: []byte{1, 24, 0, 0, 0, 0, 0, 0},
 			},
 			expectedResult: expectedResult{
-				daytime: DayTime{},
+				daytime: &DayTime{hour: 24},
+				err:     nil,
+			},
+		},
+		{
+			name:    "Checking to process an invalid component",
+			daytime: &DayTime{},
+			args: args{
+				data: []byte{1, 24, 2, 3, 0, 0, 0, 0},
+			},
+			expectedResult: expectedResult{
+				daytime: &DayTime{},
+				err:     ErrInvalid,
+			},
+		},
+		{
+			name:    "Checking to process truncated data",
+			daytime: &DayTime{},
+			args: args{
+				data: []byte{1, 1, 2, 3},
+			},
+			expectedResult: expectedResult{
+				daytime: &DayTime{},
+				err:     ErrInvalid,
+			},
+		},
+		{
+			name:    "Checking to process an unknown version",
+			daytime: &DayTime{},
+			args: args{
+				data: []byte{2, 1, 2, 3, 0, 0, 0, 0},
+			},
+			expectedResult: expectedResult{
+				daytime: &DayTime{},
 				err:     ErrInvalid,
 			},
 		},
+		{
+			name:    "Checking to process nil",
+			daytime: nil,
+			args: args{
+				data: []byte{1, 1, 2, 3, 0, 0, 0, 0},
+			},
+			expectedResult: expectedResult{
+				daytime: nil,
+				err:     ErrObjIsNil,
+			},
+		},
 	}
 	for _, test := range tests {
 		test := test
 		t.Run(test.name, func(tt *testing.T) {
 			tt.Parallel()
 
-			daytime, err := Parse(test.args.value)
-			assert.EqualValues(tt, test.expectedResult.daytime, daytime)
+			err := test.daytime.UnmarshalBinary(test.args.data)
+			assert.EqualValues(tt, test.expectedResult.daytime, test.daytime)
 			assert.ErrorIs(tt, err, test.expectedResult.err)
 		})
 	}
 }
 
-func TestString(t *testing.T) {
+func TestBinaryRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	source := DayTime{
+		hour:       12,
+		minute:     34,
+		second:     56,
+		nanosecond: 789,
+	}
+
+	data, err := source.MarshalBinary()
+	assert.NoError(t, err)
+
+	target := DayTime{}
+	err = target.UnmarshalBinary(data)
+	assert.NoError(t, err)
+	assert.EqualValues(t, source, target)
+}
+
+func TestAppendBinary(t *testing.T) {
 	t.Parallel()
 
 	tests := []struct {
 		name           string
 		daytime        *DayTime
-		expectedResult string
+		expectedResult []byte
 	}{
 		{
-			name: "Checking to get the full value",
+			name: "Checking standard work",
 			daytime: &DayTime{
 				hour:   1,
 				minute: 2,
 				second: 3,
 			},
-			expectedResult: "01:02:03",
+			expectedResult: []byte{0xff, 1, 1, 2, 3, 0, 0, 0, 0},
 		},
 		{
-			name:           "Checking to get the default value",
+			name:           "Checking to process nil",
 			daytime:        nil,
-			expectedResult: "00:00",
-		},
-		{
-			name: "Checking to get the value without second",
-			daytime: &DayTime{
-				hour:   1,
-				minute: 2,
-				second: 0,
-			},
-			expectedResult: "01:02",
+			expectedResult: []byte{0xff, 1, 0, 0, 0, 0, 0, 0, 0},
 		},
 	}
 	for _, test := range tests {
@@ -179,25 +5139,24 @@ func TestString(t *testing.T) {
 		t.Run(test.name, func(tt *testing.T) {
 			tt.Parallel()
 
-			value := test.daytime.String()
+			value, err := test.daytime.AppendBinary([]byte{0xff})
 			assert.EqualValues(tt, test.expectedResult, value)
+			assert.NoError(tt, err)
 		})
 	}
 }
 
-func TestTime(t *testing.T) {
+func TestMarshalText(t *testing.T) {
 	t.Parallel()
 
-	now := time.Now()
-	year := now.Year()
-	month := now.Month()
-	day := now.Day()
-	location := now.Location()
-
+	type expectedResult struct {
+		value []byte
+		err   error
+	}
 	tests := []struct {
 		name           string
 		daytime        *DayTime
-		expectedResult time.Time
+		expectedResult expectedResult
 	}{
 		{
 			name: "Checking standard work",
@@ -206,30 +5165,18 @@ func TestTime(t *testing.T) {
 				minute: 2,
 				second: 3,
 			},
-			expectedResult: time.Date(
-				year,
-				month,
-				day,
-				1,
-				2,
-				3,
-				0,
-				location,
-			),
+			expectedResult: expectedResult{
+				value: []byte("01:02:03"),
+				err:   nil,
+			},
 		},
 		{
-			name:    "Checking the nil value",
-			daytime: nil,
-			expectedResult: time.Date(
-				year,
-				month,
-				day,
-				0,
-				0,
-				0,
-				0,
-				location,
-			),
+			name:    "Checking to process zero",
+			daytime: &DayTime{},
+			expectedResult: expectedResult{
+				value: []byte("00:00"),
+				err:   nil,
+			},
 		},
 	}
 	for _, test := range tests {
@@ -237,65 +5184,34 @@ func TestTime(t *testing.T) {
 		t.Run(test.name, func(tt *testing.T) {
 			tt.Parallel()
 
-			value := test.daytime.Time()
-			assert.EqualValues(tt, test.expectedResult, value)
+			value, err := test.daytime.MarshalText()
+			assert.EqualValues(tt, test.expectedResult.value, value)
+			assert.EqualValues(tt, test.expectedResult.err, err)
 		})
 	}
 }
 
-func TestInTheNearFuture(t *testing.T) {
+func TestAppendText(t *testing.T) {
 	t.Parallel()
 
-	now := time.Now()
-	hour := now.Hour()
-	minute := now.Minute()
-	second := now.Second()
-	nanosecond := now.Nanosecond()
-	location := now.Location()
-	nextHour := now.Add(time.Hour)
-	nextDay := now.Add(Day)
-	time.Sleep(time.Millisecond)
-
 	tests := []struct {
 		name           string
 		daytime        *DayTime
-		expectedResult time.Time
+		expectedResult string
 	}{
 		{
-			name: "Checking to get the current day",
+			name: "Checking standard work",
 			daytime: &DayTime{
-				hour:   nextHour.Hour(),
-				minute: minute,
-				second: second,
+				hour:   1,
+				minute: 2,
+				second: 3,
 			},
-			expectedResult: time.Date(
-				now.Year(),
-				now.Month(),
-				now.Day(),
-				nextHour.Hour(),
-				minute,
-				second,
-				nanosecond,
-				location,
-			),
+			expectedResult: "start=01:02:03",
 		},
 		{
-			name: "Checking to get the next day",
-			daytime: &DayTime{
-				hour:   hour,
-				minute: minute,
-				second: second,
-			},
-			expectedResult: time.Date(
-				nextDay.Year(),
-				nextDay.Month(),
-				nextDay.Day(),
-				hour,
-				minute,
-				second,
-				nanosecond,
-				location,
-			),
+			name:           "Checking to process nil",
+			daytime:        nil,
+			expectedResult: "start=00:00",
 		},
 	}
 	for _, test := range tests {
@@ -303,70 +5219,65 @@ func TestInTheNearFuture(t *testing.T) {
 		t.Run(test.name, func(tt *testing.T) {
 			tt.Parallel()
 
-			value := test.daytime.InTheNearFuture()
-			assert.EqualValues(tt, test.expectedResult.Year(), value.Year())
-			assert.EqualValues(tt, test.expectedResult.Month(), value.Month())
-			assert.EqualValues(tt, test.expectedResult.Day(), value.Day())
-			assert.EqualValues(tt, test.expectedResult.Hour(), value.Hour())
-			assert.EqualValues(tt, test.expectedResult.Minute(), value.Minute())
-			assert.EqualValues(tt, test.expectedResult.Second(), value.Second())
+			value, err := test.daytime.AppendText([]byte("start="))
+			assert.EqualValues(tt, test.expectedResult, string(value))
+			assert.NoError(tt, err)
 		})
 	}
 }
 
-func TestInTheRecentPast(t *testing.T) {
+func TestUnmarshalText(t *testing.T) {
 	t.Parallel()
 
-	now := time.Now()
-	hour := now.Hour()
-	minute := now.Minute()
-	second := now.Second()
-	nanosecond := now.Nanosecond()
-	nextHour := now.Add(time.Hour)
-	previousDay := now.Add(-Day)
-	location := now.Location()
-	time.Sleep(time.Millisecond)
-
+	type args struct {
+		data []byte
+	}
+	type expectedResult struct {
+		daytime *DayTime
+		err     error
+	}
 	tests := []struct {
 		name           string
 		daytime        *DayTime
-		expectedResult time.Time
+		args           args
+		expectedResult expectedResult
 	}{
 		{
-			name: "Checking to get the current day",
-			daytime: &DayTime{
-				hour:   hour,
-				minute: minute,
-				second: second,
+			name:    "Checking standard work",
+			daytime: &DayTime{},
+			args: args{
+				data: []byte("01:02:03"),
+			},
+			expectedResult: expectedResult{
+				daytime: &DayTime{
+					hour:   1,
+					minute: 2,
+					second: 3,
+				},
+				err: nil,
 			},
-			expectedResult: time.Date(
-				now.Year(),
-				now.Month(),
-				now.Day(),
-				hour,
-				minute,
-				second,
-				nanosecond,
-				location,
-			),
 		},
 		{
-			name: "Checking to get the previous day",
-			daytime: &DayTime{
-				hour:   nextHour.Hour(),
-				minute: minute,
-				second: second,
+			name:    "Checking to process parse error",
+			daytime: &DayTime{},
+			args: args{
+				data: []byte("24:02:03"),
+			},
+			expectedResult: expectedResult{
+				daytime: &DayTime{},
+				err:     ErrInvalid,
+			},
+		},
+		{
+			name:    "Checking to process nil",
+			daytime: nil,
+			args: args{
+				data: []byte("01:02:03"),
+			},
+			expectedResult: expectedResult{
+				daytime: nil,
+				err:     ErrObjIsNil,
 			},
-			expectedResult: time.Date(
-				now.Year(),
-				now.Month(),
-				previousDay.Day(),
-				nextHour.Hour(),
-				minute,
-				second,
-				nanosecond,
-				location,
-			),
 		},
 	}
 	for _, test := range tests {
@@ -374,18 +5285,14 @@ func TestInTheRecentPast(t *testing.T) {
 		t.Run(test.name, func(tt *testing.T) {
 			tt.Parallel()
 
-			value := test.daytime.InTheRecentPast()
-			assert.EqualValues(tt, test.expectedResult.Year(), value.Year())
-			assert.EqualValues(tt, test.expectedResult.Month(), value.Month())
-			assert.EqualValues(tt, test.expectedResult.Day(), value.Day())
-			assert.EqualValues(tt, test.expectedResult.Hour(), value.Hour())
-			assert.EqualValues(tt, test.expectedResult.Minute(), value.Minute())
-			assert.EqualValues(tt, test.expectedResult.Second(), value.Second())
+			err := test.daytime.UnmarshalText(test.args.data)
+			assert.EqualValues(tt, test.expectedResult.daytime, test.daytime)
+			assert.ErrorIs(tt, err, test.expectedResult.err)
 		})
 	}
 }
 
-func TestMarshalBinary(t *testing.T) {
+func TestMarshalJSON(t *testing.T) {
 	t.Parallel()
 
 	type expectedResult struct {
@@ -405,15 +5312,15 @@ func TestMarshalBinary(t *testing.T) {
 				second: 3,
 			},
 			expectedResult: expectedResult{
-				value: []byte("01:02:03"),
+				value: []byte(`"01:02:03"`),
 				err:   nil,
 			},
 		},
 		{
-			name:    "Checking to process nil",
-			daytime: nil,
+			name:    "Checking to process zero",
+			daytime: &DayTime{},
 			expectedResult: expectedResult{
-				value: []byte("00:00"),
+				value: []byte(`"00:00"`),
 				err:   nil,
 			},
 		},
@@ -423,14 +5330,14 @@ func TestMarshalBinary(t *testing.T) {
 		t.Run(test.name, func(tt *testing.T) {
 			tt.Parallel()
 
-			value, err := test.daytime.MarshalBinary()
+			value, err := test.daytime.MarshalJSON()
 			assert.EqualValues(tt, test.expectedResult.value, value)
 			assert.EqualValues(tt, test.expectedResult.err, err)
 		})
 	}
 }
 
-func TestUnmarshalBinary(t *testing.T) {
+func TestJSONNumber(t *testing.T) {
 	t.Parallel()
 
 	type args struct {
@@ -450,7 +5357,100 @@ func TestUnmarshalBinary(t *testing.T) {
 			name:    "Checking standard work",
 			daytime: &DayTime{},
 			args: args{
-				data: []byte("01:02:03"),
+				data: []byte(`3723`),
+			},
+			expectedResult: expectedResult{
+				daytime: &DayTime{
+					hour:   1,
+					minute: 2,
+					second: 3,
+				},
+				err: nil,
+			},
+		},
+		{
+			name:    "Checking to process zero",
+			daytime: &DayTime{hour: 1},
+			args: args{
+				data: []byte(`0`),
+			},
+			expectedResult: expectedResult{
+				daytime: &DayTime{},
+				err:     nil,
+			},
+		},
+		{
+			name:    "Checking to process an out of range number",
+			daytime: &DayTime{},
+			args: args{
+				data: []byte(`86400`),
+			},
+			expectedResult: expectedResult{
+				daytime: &DayTime{},
+				err:     ErrInvalid,
+			},
+		},
+		{
+			name:    "Checking to process a string",
+			daytime: &DayTime{},
+			args: args{
+				data: []byte(`"01:02:03"`),
+			},
+			expectedResult: expectedResult{
+				daytime: &DayTime{},
+				err:     ErrInvalid,
+			},
+		},
+		{
+			name:    "Checking to process nil",
+			daytime: nil,
+			args: args{
+				data: []byte(`3723`),
+			},
+			expectedResult: expectedResult{
+				daytime: nil,
+				err:     ErrObjIsNil,
+			},
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+
+			err := test.daytime.UnmarshalJSONNumber(test.args.data)
+			assert.EqualValues(tt, test.expectedResult.daytime, test.daytime)
+			assert.ErrorIs(tt, err, test.expectedResult.err)
+			if err == nil {
+				data, err := test.daytime.MarshalJSONNumber()
+				assert.EqualValues(tt, test.args.data, data)
+				assert.NoError(tt, err)
+			}
+		})
+	}
+}
+
+func TestUnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		data []byte
+	}
+	type expectedResult struct {
+		daytime *DayTime
+		err     error
+	}
+	tests := []struct {
+		name           string
+		daytime        *DayTime
+		args           args
+		expectedResult expectedResult
+	}{
+		{
+			name:    "Checking standard work",
+			daytime: &DayTime{},
+			args: args{
+				data: []byte(`"01:02:03"`),
 			},
 			expectedResult: expectedResult{
 				daytime: &DayTime{
@@ -465,7 +5465,7 @@ func TestUnmarshalBinary(t *testing.T) {
 			name:    "Checking to process parse error",
 			daytime: &DayTime{},
 			args: args{
-				data: []byte("24:02:03"),
+				data: []byte(`"24:02:03"`),
 			},
 			expectedResult: expectedResult{
 				daytime: &DayTime{},
@@ -476,31 +5476,135 @@ func TestUnmarshalBinary(t *testing.T) {
 			name:    "Checking to process nil",
 			daytime: nil,
 			args: args{
-				data: []byte("01:02:03"),
+				data: []byte(`"01:02:03"`),
 			},
 			expectedResult: expectedResult{
 				daytime: nil,
 				err:     ErrObjIsNil,
 			},
 		},
+		{
+			name: "Checking to process null",
+			daytime: &DayTime{
+				hour:   1,
+				minute: 2,
+				second: 3,
+			},
+			args: args{
+				data: []byte(`null`),
+			},
+			expectedResult: expectedResult{
+				daytime: &DayTime{
+					hour:   1,
+					minute: 2,
+					second: 3,
+				},
+				err: nil,
+			},
+		},
+		{
+			name:    "Checking to process unquoted token",
+			daytime: &DayTime{},
+			args: args{
+				data: []byte(`01:02:03`),
+			},
+			expectedResult: expectedResult{
+				daytime: &DayTime{},
+				err:     ErrInvalid,
+			},
+		},
+		{
+			name:    "Checking to process malformed token",
+			daytime: &DayTime{},
+			args: args{
+				data: []byte(`"01:02:03`),
+			},
+			expectedResult: expectedResult{
+				daytime: &DayTime{},
+				err:     ErrInvalid,
+			},
+		},
 	}
 	for _, test := range tests {
 		test := test
 		t.Run(test.name, func(tt *testing.T) {
 			tt.Parallel()
 
-			err := test.daytime.UnmarshalBinary(test.args.data)
+			err := test.daytime.UnmarshalJSON(test.args.data)
 			assert.EqualValues(tt, test.expectedResult.daytime, test.daytime)
 			assert.ErrorIs(tt, err, test.expectedResult.err)
 		})
 	}
 }
 
-func TestMarshalText(t *testing.T) {
+func TestJSONRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	type schedule struct {
+		Start *DayTime `json:"start"`
+	}
+	source := schedule{
+		Start: &DayTime{
+			hour:   1,
+			minute: 2,
+			second: 3,
+		},
+	}
+
+	data, err := json.Marshal(source)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"start":"01:02:03"}`, string(data))
+
+	target := schedule{}
+	err = json.Unmarshal(data, &target)
+	assert.NoError(t, err)
+	assert.EqualValues(t, source, target)
+}
+
+func TestMarshalByValue(t *testing.T) {
+	t.Parallel()
+
+	type schedule struct {
+		Start DayTime `json:"start"`
+	}
+	source := schedule{
+		Start: DayTime{
+			hour:   1,
+			minute: 2,
+			second: 3,
+		},
+	}
+
+	data, err := json.Marshal(source)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"start":"01:02:03"}`, string(data))
+
+	assert.Implements(t, (*encoding.TextMarshaler)(nil), source.Start)
+	assert.Implements(t, (*encoding.BinaryMarshaler)(nil), source.Start)
+	assert.Implements(t, (*json.Marshaler)(nil), source.Start)
+}
+
+func TestMarshalNil(t *testing.T) {
+	t.Parallel()
+
+	type schedule struct {
+		Start *DayTime `json:"start"`
+	}
+
+	data, err := json.Marshal(schedule{})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"start":null}`, string(data))
+
+	var target schedule
+	assert.NoError(t, json.Unmarshal(data, &target))
+	assert.Nil(t, target.Start)
+}
+
+func TestMarshalCSV(t *testing.T) {
 	t.Parallel()
 
 	type expectedResult struct {
-		value []byte
+		value string
 		err   error
 	}
 	tests := []struct {
@@ -516,15 +5620,15 @@ func TestMarshalText(t *testing.T) {
 				second: 3,
 			},
 			expectedResult: expectedResult{
-				value: []byte("01:02:03"),
+				value: "01:02:03",
 				err:   nil,
 			},
 		},
 		{
-			name:    "Checking to process nil",
-			daytime: nil,
+			name:    "Checking to process zero",
+			daytime: &DayTime{},
 			expectedResult: expectedResult{
-				value: []byte("00:00"),
+				value: "00:00",
 				err:   nil,
 			},
 		},
@@ -534,18 +5638,18 @@ func TestMarshalText(t *testing.T) {
 		t.Run(test.name, func(tt *testing.T) {
 			tt.Parallel()
 
-			value, err := test.daytime.MarshalText()
+			value, err := test.daytime.MarshalCSV()
 			assert.EqualValues(tt, test.expectedResult.value, value)
 			assert.EqualValues(tt, test.expectedResult.err, err)
 		})
 	}
 }
 
-func TestUnmarshalText(t *testing.T) {
+func TestUnmarshalCSV(t *testing.T) {
 	t.Parallel()
 
 	type args struct {
-		data []byte
+		str string
 	}
 	type expectedResult struct {
 		daytime *DayTime
@@ -561,7 +5665,7 @@ func TestUnmarshalText(t *testing.T) {
 			name:    "Checking standard work",
 			daytime: &DayTime{},
 			args: args{
-				data: []byte("01:02:03"),
+				str: "01:02:03",
 			},
 			expectedResult: expectedResult{
 				daytime: &DayTime{
@@ -576,7 +5680,7 @@ func TestUnmarshalText(t *testing.T) {
 			name:    "Checking to process parse error",
 			daytime: &DayTime{},
 			args: args{
-				data: []byte("24:02:03"),
+				str: "24:02:03",
 			},
 			expectedResult: expectedResult{
 				daytime: &DayTime{},
@@ -587,7 +5691,7 @@ func TestUnmarshalText(t *testing.T) {
 			name:    "Checking to process nil",
 			daytime: nil,
 			args: args{
-				data: []byte("01:02:03"),
+				str: "01:02:03",
 			},
 			expectedResult: expectedResult{
 				daytime: nil,
@@ -600,44 +5704,159 @@ func TestUnmarshalText(t *testing.T) {
 		t.Run(test.name, func(tt *testing.T) {
 			tt.Parallel()
 
-			err := test.daytime.UnmarshalText(test.args.data)
+			err := test.daytime.UnmarshalCSV(test.args.str)
 			assert.EqualValues(tt, test.expectedResult.daytime, test.daytime)
 			assert.ErrorIs(tt, err, test.expectedResult.err)
 		})
 	}
 }
 
-func TestMarshalJSON(t *testing.T) {
+func TestGobRoundTrip(t *testing.T) {
 	t.Parallel()
 
-	type expectedResult struct {
-		value []byte
-		err   error
+	type schedule struct {
+		Name  string
+		Start DayTime
+		End   *DayTime
+	}
+	source := schedule{
+		Name: "shift",
+		Start: DayTime{
+			hour:   1,
+			minute: 2,
+			second: 3,
+		},
+		End: &DayTime{
+			hour:       23,
+			nanosecond: 500000000,
+		},
+	}
+
+	buf := bytes.Buffer{}
+	err := gob.NewEncoder(&buf).Encode(source)
+	assert.NoError(t, err)
+
+	target := schedule{}
+	err = gob.NewDecoder(&buf).Decode(&target)
+	assert.NoError(t, err)
+	assert.EqualValues(t, source, target)
+}
+
+func TestGobDecode(t *testing.T) {
+	t.Parallel()
+
+	var daytime *DayTime
+	assert.ErrorIs(t, daytime.GobDecode([]byte{1, 1, 2, 3, 0, 0, 0, 0}), ErrObjIsNil)
+
+	daytime = &DayTime{}
+	assert.ErrorIs(t, daytime.GobDecode([]byte("24:00")), ErrInvalid)
+}
+
+func TestXMLRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	type schedule struct {
+		XMLName xml.Name `xml:"schedule"`
+		Start   DayTime  `xml:"start"`
+		End     DayTime  `xml:"end,attr"`
+	}
+	source := schedule{
+		XMLName: xml.Name{Local: "schedule"},
+		Start: DayTime{
+			hour:   1,
+			minute: 2,
+			second: 3,
+		},
+		End: DayTime{
+			hour: 17,
+		},
+	}
+
+	data, err := xml.Marshal(source)
+	assert.NoError(t, err)
+	assert.EqualValues(t, `<schedule end="17:00"><start>01:02:03</start></schedule>`, string(data))
+
+	target := schedule{}
+	err = xml.Unmarshal(data, &target)
+	assert.NoError(t, err)
+	assert.EqualValues(t, source, target)
+}
+
+func TestUnmarshalXML(t *testing.T) {
+	t.Parallel()
+
+	type schedule struct {
+		Start *DayTime `xml:"start"`
+		End   *DayTime `xml:"end,attr"`
 	}
 	tests := []struct {
 		name           string
-		daytime        *DayTime
-		expectedResult expectedResult
+		data           string
+		expectedResult error
 	}{
 		{
-			name: "Checking standard work",
-			daytime: &DayTime{
-				hour:   1,
-				minute: 2,
-				second: 3,
-			},
-			expectedResult: expectedResult{
-				value: []byte("01:02:03"),
-				err:   nil,
-			},
+			name:           "Checking the processing of an invalid element",
+			data:           `<schedule><start>24:00</start></schedule>`,
+			expectedResult: ErrInvalid,
+		},
+		{
+			name:           "Checking the processing of an invalid attribute",
+			data:           `<schedule end="9"></schedule>`,
+			expectedResult: ErrInvalid,
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+
+			err := xml.Unmarshal([]byte(test.data), &schedule{})
+			assert.ErrorIs(tt, err, test.expectedResult)
+		})
+	}
+
+	var daytime *DayTime
+	assert.ErrorIs(t, daytime.UnmarshalXMLAttr(xml.Attr{Value: "01:02"}), ErrObjIsNil)
+	assert.ErrorIs(t, daytime.UnmarshalXML(xml.NewDecoder(nil), xml.StartElement{}), ErrObjIsNil)
+}
+
+func TestYAMLRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	type schedule struct {
+		Shift DayTime `yaml:"shift"`
+	}
+
+	target := schedule{}
+	err := yaml.Unmarshal([]byte(`shift: "09:00"`), &target)
+	assert.NoError(t, err)
+	assert.EqualValues(t, schedule{Shift: DayTime{hour: 9}}, target)
+
+	data, err := yaml.Marshal(target)
+	assert.NoError(t, err)
+	assert.EqualValues(t, "shift: \"09:00\"\n", string(data))
+}
+
+func TestUnmarshalYAML(t *testing.T) {
+	t.Parallel()
+
+	type schedule struct {
+		Shift *DayTime `yaml:"shift"`
+	}
+	tests := []struct {
+		name           string
+		data           string
+		expectedResult error
+	}{
+		{
+			name:           "Checking the processing of an invalid value",
+			data:           `shift: "24:00"`,
+			expectedResult: ErrInvalid,
 		},
 		{
-			name:    "Checking to process nil",
-			daytime: nil,
-			expectedResult: expectedResult{
-				value: []byte("00:00"),
-				err:   nil,
-			},
+			name:           "Checking the processing of an unquoted value",
+			data:           `shift: 9`,
+			expectedResult: ErrInvalid,
 		},
 	}
 	for _, test := range tests {
@@ -645,18 +5864,20 @@ func TestMarshalJSON(t *testing.T) {
 		t.Run(test.name, func(tt *testing.T) {
 			tt.Parallel()
 
-			value, err := test.daytime.MarshalJSON()
-			assert.EqualValues(tt, test.expectedResult.value, value)
-			assert.EqualValues(tt, test.expectedResult.err, err)
+			err := yaml.Unmarshal([]byte(test.data), &schedule{})
+			assert.ErrorIs(tt, err, test.expectedResult)
 		})
 	}
+
+	var daytime *DayTime
+	assert.ErrorIs(t, daytime.UnmarshalYAML(func(interface{}) error { return nil }), ErrObjIsNil)
 }
 
-func TestUnmarshalJSON(t *testing.T) {
+func TestSet(t *testing.T) {
 	t.Parallel()
 
 	type args struct {
-		data []byte
+		value string
 	}
 	type expectedResult struct {
 		daytime *DayTime
@@ -670,27 +5891,23 @@ func TestUnmarshalJSON(t *testing.T) {
 	}{
 		{
 			name:    "Checking standard work",
-			daytime: &DayTime{},
+			daytime: &DayTime{hour: 8},
 			args: args{
-				data: []byte("01:02:03"),
+				value: "09:30",
 			},
 			expectedResult: expectedResult{
-				daytime: &DayTime{
-					hour:   1,
-					minute: 2,
-					second: 3,
-				},
-				err: nil,
+				daytime: &DayTime{hour: 9, minute: 30},
+				err:     nil,
 			},
 		},
 		{
 			name:    "Checking to process parse error",
-			daytime: &DayTime{},
+			daytime: &DayTime{hour: 8},
 			args: args{
-				data: []byte("24:02:03"),
+				value: "9:30",
 			},
 			expectedResult: expectedResult{
-				daytime: &DayTime{},
+				daytime: &DayTime{hour: 8},
 				err:     ErrInvalid,
 			},
 		},
@@ -698,7 +5915,7 @@ func TestUnmarshalJSON(t *testing.T) {
 			name:    "Checking to process nil",
 			daytime: nil,
 			args: args{
-				data: []byte("01:02:03"),
+				value: "09:30",
 			},
 			expectedResult: expectedResult{
 				daytime: nil,
@@ -711,44 +5928,83 @@ func TestUnmarshalJSON(t *testing.T) {
 		t.Run(test.name, func(tt *testing.T) {
 			tt.Parallel()
 
-			err := test.daytime.UnmarshalJSON(test.args.data)
+			err := test.daytime.Set(test.args.value)
 			assert.EqualValues(tt, test.expectedResult.daytime, test.daytime)
 			assert.ErrorIs(tt, err, test.expectedResult.err)
 		})
 	}
 }
 
-func TestMarshalCSV(t *testing.T) {
+func TestFlagSet(t *testing.T) {
+	t.Parallel()
+
+	daytime := DayTime{hour: 8}
+	flags := flag.NewFlagSet("test", flag.ContinueOnError)
+	flags.SetOutput(io.Discard)
+	flags.Var(&daytime, "start", "start of the shift")
+
+	err := flags.Parse([]string{"-start", "09:30"})
+	assert.NoError(t, err)
+	assert.EqualValues(t, DayTime{hour: 9, minute: 30}, daytime)
+
+	err = flags.Parse([]string{"-start", "9:30"})
+	assert.Error(t, err)
+	assert.EqualValues(t, DayTime{hour: 9, minute: 30}, daytime)
+}
+
+func TestFlag(t *testing.T) {
+	commandLine := flag.CommandLine
+	flag.CommandLine = flag.NewFlagSet("daytime-test", flag.ContinueOnError)
+	t.Cleanup(func() {
+		flag.CommandLine = commandLine
+	})
+
+	daytime := Flag("daytime-test-start", DayTime{hour: 8}, "start of the shift")
+	assert.EqualValues(t, DayTime{hour: 8}, *daytime)
+
+	err := flag.Set("daytime-test-start", "09:30")
+	assert.NoError(t, err)
+	assert.EqualValues(t, DayTime{hour: 9, minute: 30}, *daytime)
+}
+
+func TestType(t *testing.T) {
+	t.Parallel()
+
+	// The method set of pflag.Value.
+	var value interface {
+		String() string
+		Set(string) error
+		Type() string
+	} = &DayTime{}
+
+	assert.EqualValues(t, "daytime", value.Type())
+	assert.NoError(t, value.Set("09:30"))
+	assert.EqualValues(t, "09:30", value.String())
+}
+
+func TestLogValue(t *testing.T) {
 	t.Parallel()
 
-	type expectedResult struct {
-		value string
-		err   error
-	}
 	tests := []struct {
 		name           string
-		daytime        *DayTime
-		expectedResult expectedResult
+		value          any
+		expectedResult string
 	}{
 		{
 			name: "Checking standard work",
-			daytime: &DayTime{
+			value: DayTime{
 				hour:   1,
 				minute: 2,
 				second: 3,
 			},
-			expectedResult: expectedResult{
-				value: "01:02:03",
-				err:   nil,
-			},
+			expectedResult: `{"msg":"shift","start":"01:02:03"}` + "\n",
 		},
 		{
-			name:    "Checking to process nil",
-			daytime: nil,
-			expectedResult: expectedResult{
-				value: "00:00",
-				err:   nil,
+			name: "Checking to process a pointer",
+			value: &DayTime{
+				hour: 9,
 			},
+			expectedResult: `{"msg":"shift","start":"09:00"}` + "\n",
 		},
 	}
 	for _, test := range tests {
@@ -756,18 +6012,28 @@ func TestMarshalCSV(t *testing.T) {
 		t.Run(test.name, func(tt *testing.T) {
 			tt.Parallel()
 
-			value, err := test.daytime.MarshalCSV()
-			assert.EqualValues(tt, test.expectedResult.value, value)
-			assert.EqualValues(tt, test.expectedResult.err, err)
+			buf := bytes.Buffer{}
+			logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{
+				ReplaceAttr: func(groups []string, attr slog.Attr) slog.Attr {
+					if attr.Key == slog.TimeKey || attr.Key == slog.LevelKey {
+						return slog.Attr{}
+					}
+
+					return attr
+				},
+			}))
+
+			logger.Info("shift", "start", test.value)
+			assert.EqualValues(tt, test.expectedResult, buf.String())
 		})
 	}
 }
 
-func TestUnmarshalCSV(t *testing.T) {
+func TestScan(t *testing.T) {
 	t.Parallel()
 
 	type args struct {
-		str string
+		src any
 	}
 	type expectedResult struct {
 		daytime *DayTime
@@ -780,10 +6046,10 @@ func TestUnmarshalCSV(t *testing.T) {
 		expectedResult expectedResult
 	}{
 		{
-			name:    "Checking standard work",
+			name:    "Checking to process string",
 			daytime: &DayTime{},
 			args: args{
-				str: "01:02:03",
+				src: "01:02:03",
 			},
 			expectedResult: expectedResult{
 				daytime: &DayTime{
@@ -795,61 +6061,40 @@ func TestUnmarshalCSV(t *testing.T) {
 			},
 		},
 		{
-			name:    "Checking to process parse error",
+			name:    "Checking to process bytes",
 			daytime: &DayTime{},
 			args: args{
-				str: "24:02:03",
+				src: []byte("01:02:03"),
 			},
 			expectedResult: expectedResult{
-				daytime: &DayTime{},
-				err:     ErrInvalid,
+				daytime: &DayTime{
+					hour:   1,
+					minute: 2,
+					second: 3,
+				},
+				err: nil,
 			},
 		},
 		{
-			name:    "Checking to process nil",
-			daytime: nil,
+			name:    "Checking to process time",
+			daytime: &DayTime{},
 			args: args{
-				str: "01:02:03",
+				src: time.Date(2024, time.March, 15, 1, 2, 3, 4, time.UTC),
 			},
 			expectedResult: expectedResult{
-				daytime: nil,
-				err:     ErrObjIsNil,
+				daytime: &DayTime{
+					hour:   1,
+					minute: 2,
+					second: 3,
+				},
+				err: nil,
 			},
 		},
-	}
-	for _, test := range tests {
-		test := test
-		t.Run(test.name, func(tt *testing.T) {
-			tt.Parallel()
-
-			err := test.daytime.UnmarshalCSV(test.args.str)
-			assert.EqualValues(tt, test.expectedResult.daytime, test.daytime)
-			assert.ErrorIs(tt, err, test.expectedResult.err)
-		})
-	}
-}
-
-func TestScan(t *testing.T) {
-	t.Parallel()
-
-	type args struct {
-		src any
-	}
-	type expectedResult struct {
-		daytime *DayTime
-		err     error
-	}
-	tests := []struct {
-		name           string
-		daytime        *DayTime
-		args           args
-		expectedResult expectedResult
-	}{
 		{
-			name:    "Checking to process string",
+			name:    "Checking to process int64",
 			daytime: &DayTime{},
 			args: args{
-				src: "01:02:03",
+				src: int64(3723),
 			},
 			expectedResult: expectedResult{
 				daytime: &DayTime{
@@ -861,20 +6106,57 @@ func TestScan(t *testing.T) {
 			},
 		},
 		{
-			name:    "Checking to process bytes",
+			name:    "Checking to process int",
 			daytime: &DayTime{},
 			args: args{
-				src: []byte("01:02:03"),
+				src: 86399,
 			},
 			expectedResult: expectedResult{
 				daytime: &DayTime{
-					hour:   1,
-					minute: 2,
-					second: 3,
+					hour:   23,
+					minute: 59,
+					second: 59,
 				},
 				err: nil,
 			},
 		},
+		{
+			name:    "Checking to process an out of range int64",
+			daytime: &DayTime{},
+			args: args{
+				src: int64(86400),
+			},
+			expectedResult: expectedResult{
+				daytime: &DayTime{},
+				err:     ErrInvalid,
+			},
+		},
+		{
+			name:    "Checking to process a negative int64",
+			daytime: &DayTime{},
+			args: args{
+				src: int64(-1),
+			},
+			expectedResult: expectedResult{
+				daytime: &DayTime{},
+				err:     ErrInvalid,
+			},
+		},
+		{
+			name: "Checking to process NULL",
+			daytime: &DayTime{
+				hour:   1,
+				minute: 2,
+				second: 3,
+			},
+			args: args{
+				src: nil,
+			},
+			expectedResult: expectedResult{
+				daytime: &DayTime{},
+				err:     nil,
+			},
+		},
 		{
 			name:    "Checking to process nil",
 			daytime: nil,
@@ -921,6 +6203,28 @@ func TestScan(t *testing.T) {
 	}
 }
 
+func TestScanWritesNothingToStdout(t *testing.T) {
+	reader, writer, err := os.Pipe()
+	assert.NoError(t, err)
+
+	stdout := os.Stdout
+	os.Stdout = writer
+	t.Cleanup(func() {
+		os.Stdout = stdout
+	})
+
+	daytime := DayTime{}
+	err = daytime.Scan([]byte("01:02:03"))
+	assert.NoError(t, err)
+
+	os.Stdout = stdout
+	assert.NoError(t, writer.Close())
+
+	output, err := io.ReadAll(reader)
+	assert.NoError(t, err)
+	assert.Empty(t, output)
+}
+
 func TestValue(t *testing.T) {
 	t.Parallel()
 
@@ -945,11 +6249,21 @@ func TestValue(t *testing.T) {
 				err:   nil,
 			},
 		},
+		{
+			name: "Checking to get the value without second",
+			daytime: &DayTime{
+				hour: 9,
+			},
+			expectedResult: expectedResult{
+				value: "09:00:00",
+				err:   nil,
+			},
+		},
 		{
 			name:    "Checking to process nil",
 			daytime: nil,
 			expectedResult: expectedResult{
-				value: "00:00",
+				value: "00:00:00",
 				err:   nil,
 			},
 		},
@@ -965,3 +6279,112 @@ func TestValue(t *testing.T) {
 		})
 	}
 }
+
+func TestValueOrNull(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		daytime        *DayTime
+		expectedResult driver.Value
+	}{
+		{
+			name: "Checking standard work",
+			daytime: &DayTime{
+				hour:   1,
+				minute: 2,
+				second: 3,
+			},
+			expectedResult: "01:02:03",
+		},
+		{
+			name:           "Checking to process zero",
+			daytime:        &DayTime{},
+			expectedResult: nil,
+		},
+		{
+			name:           "Checking to process nil",
+			daytime:        nil,
+			expectedResult: nil,
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+
+			value, err := test.daytime.ValueOrNull()
+			assert.EqualValues(tt, test.expectedResult, value)
+			assert.NoError(tt, err)
+		})
+	}
+}
+
+func TestValueSeconds(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		daytime        *DayTime
+		expectedResult driver.Value
+	}{
+		{
+			name: "Checking standard work",
+			daytime: &DayTime{
+				hour:   1,
+				minute: 2,
+				second: 3,
+			},
+			expectedResult: int64(3723),
+		},
+		{
+			name:           "Checking to process zero",
+			daytime:        &DayTime{},
+			expectedResult: int64(0),
+		},
+		{
+			name:           "Checking to process nil",
+			daytime:        nil,
+			expectedResult: int64(0),
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+
+			value, err := test.daytime.ValueSeconds()
+			assert.Equal(tt, test.expectedResult, value)
+			assert.NoError(tt, err)
+		})
+	}
+}
+
+func BenchmarkParse(b *testing.B) {
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		_, _ = Parse("01:02:03")
+	}
+}
+
+func BenchmarkIsValidString(b *testing.B) {
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		_ = IsValidString("24:02:03")
+	}
+}
+
+func BenchmarkString(b *testing.B) {
+	b.ReportAllocs()
+
+	daytime := &DayTime{
+		hour:   1,
+		minute: 2,
+		second: 3,
+	}
+	for i := 0; i < b.N; i++ {
+		_ = daytime.String()
+	}
+}
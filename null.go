@@ -0,0 +1,78 @@
+package daytime
+
+import (
+	"database/sql/driver"
+
+	"github.com/pkg/errors"
+)
+
+// NullDayTime represents a daytime that may be null.
+// It follows the sql.NullString pattern.
+type NullDayTime struct {
+	DayTime DayTime
+	Valid   bool // Valid is true if DayTime is not NULL
+}
+
+func (n *NullDayTime) Scan(src any) error {
+	if n == nil {
+		return ErrObjIsNil
+	}
+
+	if src == nil {
+		*n = NullDayTime{}
+
+		return nil
+	}
+
+	value := DayTime{}
+	if err := value.Scan(src); err != nil {
+		return errors.Wrap(err, "scan")
+	}
+
+	*n = NullDayTime{
+		DayTime: value,
+		Valid:   true,
+	}
+
+	return nil
+}
+
+func (n NullDayTime) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+
+	return n.DayTime.Value()
+}
+
+func (n NullDayTime) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+
+	return n.DayTime.MarshalJSON()
+}
+
+func (n *NullDayTime) UnmarshalJSON(data []byte) error {
+	if n == nil {
+		return ErrObjIsNil
+	}
+
+	if string(data) == "null" {
+		*n = NullDayTime{}
+
+		return nil
+	}
+
+	value := DayTime{}
+	if err := value.UnmarshalJSON(data); err != nil {
+		return errors.Wrap(err, "unmarshal")
+	}
+
+	*n = NullDayTime{
+		DayTime: value,
+		Valid:   true,
+	}
+
+	return nil
+}
@@ -0,0 +1,201 @@
+package daytime
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNullDayTimeScan(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		src any
+	}
+	type expectedResult struct {
+		nullDayTime *NullDayTime
+		err         error
+	}
+	tests := []struct {
+		name           string
+		nullDayTime    *NullDayTime
+		args           args
+		expectedResult expectedResult
+	}{
+		{
+			name: "Checking to process nil",
+			nullDayTime: &NullDayTime{
+				DayTime: DayTime{hour: 1},
+				Valid:   true,
+			},
+			args: args{
+				src: nil,
+			},
+			expectedResult: expectedResult{
+				nullDayTime: &NullDayTime{},
+				err:         nil,
+			},
+		},
+		{
+			name:        "Checking to process string",
+			nullDayTime: &NullDayTime{},
+			args: args{
+				src: "01:02:03",
+			},
+			expectedResult: expectedResult{
+				nullDayTime: &NullDayTime{
+					DayTime: DayTime{
+						hour:   1,
+						minute: 2,
+						second: 3,
+					},
+					Valid: true,
+				},
+				err: nil,
+			},
+		},
+		{
+			name:        "Checking to process bytes",
+			nullDayTime: &NullDayTime{},
+			args: args{
+				src: []byte("01:02:03"),
+			},
+			expectedResult: expectedResult{
+				nullDayTime: &NullDayTime{
+					DayTime: DayTime{
+						hour:   1,
+						minute: 2,
+						second: 3,
+					},
+					Valid: true,
+				},
+				err: nil,
+			},
+		},
+		{
+			name:        "Checking to process parse error",
+			nullDayTime: &NullDayTime{},
+			args: args{
+				src: "24:02:03",
+			},
+			expectedResult: expectedResult{
+				nullDayTime: &NullDayTime{},
+				err:         ErrInvalid,
+			},
+		},
+		{
+			name:        "Checking to process nil receiver",
+			nullDayTime: nil,
+			args: args{
+				src: "01:02:03",
+			},
+			expectedResult: expectedResult{
+				nullDayTime: nil,
+				err:         ErrObjIsNil,
+			},
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+
+			err := test.nullDayTime.Scan(test.args.src)
+			assert.EqualValues(tt, test.expectedResult.nullDayTime, test.nullDayTime)
+			assert.ErrorIs(tt, err, test.expectedResult.err)
+		})
+	}
+}
+
+func TestNullDayTimeValue(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		nullDayTime    NullDayTime
+		expectedResult driver.Value
+	}{
+		{
+			name: "Checking standard work",
+			nullDayTime: NullDayTime{
+				DayTime: DayTime{
+					hour:   1,
+					minute: 2,
+					second: 3,
+				},
+				Valid: true,
+			},
+			expectedResult: "01:02:03",
+		},
+		{
+			name:           "Checking to process null",
+			nullDayTime:    NullDayTime{},
+			expectedResult: nil,
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+
+			value, err := test.nullDayTime.Value()
+			assert.EqualValues(tt, test.expectedResult, value)
+			assert.NoError(tt, err)
+		})
+	}
+}
+
+func TestNullDayTimeJSON(t *testing.T) {
+	t.Parallel()
+
+	type schedule struct {
+		Start NullDayTime `json:"start"`
+	}
+	tests := []struct {
+		name           string
+		schedule       schedule
+		expectedResult string
+	}{
+		{
+			name: "Checking standard work",
+			schedule: schedule{
+				Start: NullDayTime{
+					DayTime: DayTime{
+						hour:   1,
+						minute: 2,
+						second: 3,
+					},
+					Valid: true,
+				},
+			},
+			expectedResult: `{"start":"01:02:03"}`,
+		},
+		{
+			name:           "Checking to process null",
+			schedule:       schedule{},
+			expectedResult: `{"start":null}`,
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+
+			data, err := json.Marshal(test.schedule)
+			assert.NoError(tt, err)
+			assert.JSONEq(tt, test.expectedResult, string(data))
+
+			value := schedule{
+				Start: NullDayTime{
+					DayTime: DayTime{hour: 23},
+					Valid:   true,
+				},
+			}
+			err = json.Unmarshal(data, &value)
+			assert.NoError(tt, err)
+			assert.EqualValues(tt, test.schedule, value)
+		})
+	}
+}
@@ -0,0 +1,268 @@
+package daytime
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// DayTimeRange is an interval of the day, e.g. business hours 09:00-17:00.
+type DayTimeRange struct {
+	Start DayTime
+	End   DayTime
+}
+
+// ParseRange parse a range in the START-END form, e.g. 09:00-17:00.
+// The start is parsed by Parse, the end by ParseAllowEndOfDay,
+// so a range may end at 24:00.
+func ParseRange(value string) (DayTimeRange, error) {
+	start, end, found := strings.Cut(value, "-")
+	if !found {
+		return DayTimeRange{}, errors.Wrap(ErrInvalid, fmt.Sprintf("range '%s'", value))
+	}
+
+	startTime, err := Parse(start)
+	if err != nil {
+		return DayTimeRange{}, errors.Wrap(err, "range start")
+	}
+
+	endTime, err := ParseAllowEndOfDay(end)
+	if err != nil {
+		return DayTimeRange{}, errors.Wrap(err, "range end")
+	}
+
+	return DayTimeRange{Start: startTime, End: endTime}, nil
+}
+
+// String convert to string in the START-END form.
+func (r DayTimeRange) String() string {
+	return r.Start.String() + "-" + r.End.String()
+}
+
+func (r DayTimeRange) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + r.String() + `"`), nil
+}
+
+// UnmarshalJSON parses a JSON string by ParseRange, null maps to the zero range.
+func (r *DayTimeRange) UnmarshalJSON(data []byte) error {
+	if r == nil {
+		return ErrObjIsNil
+	}
+
+	str := string(data)
+	if str == "null" {
+		*r = DayTimeRange{}
+
+		return nil
+	}
+
+	if len(str) < 2 || str[0] != '"' || str[len(str)-1] != '"' {
+		return errors.Wrap(ErrInvalid, fmt.Sprintf("json token '%s'", str))
+	}
+
+	value, err := ParseRange(str[1 : len(str)-1])
+	if err != nil {
+		return errors.Wrap(err, "parse")
+	}
+
+	*r = value
+
+	return nil
+}
+
+// Contains reports whether the daytime lies within the range.
+// The inclusive flag controls whether the endpoints match.
+// If the start is after the end, the range is treated as crossing midnight.
+func (r DayTimeRange) Contains(t DayTime, inclusive bool) bool {
+	return t.Between(&r.Start, &r.End, inclusive)
+}
+
+// Duration returns the length of the range. If the start is after the end,
+// the range crosses midnight, e.g. 22:00-02:00 lasts 4h.
+func (r DayTimeRange) Duration() time.Duration {
+	if r.Start.After(&r.End) {
+		return Day - r.Start.Sub(&r.End)
+	}
+
+	return r.End.Sub(&r.Start)
+}
+
+// Overlaps reports whether the ranges have any time in common.
+// Ranges that only touch at an endpoint do not overlap.
+func (r DayTimeRange) Overlaps(other DayTimeRange) bool {
+	_, ok := r.Intersect(other)
+
+	return ok
+}
+
+// Intersect returns the common part of the ranges and whether it exists.
+// Two ranges crossing midnight may have several common parts, e.g.
+// 22:00-06:00 and 05:00-23:00, in that case the earliest starting one is returned.
+func (r DayTimeRange) Intersect(other DayTimeRange) (DayTimeRange, bool) {
+	var parts [][2]time.Duration
+
+	for _, a := range r.intervals() {
+		for _, b := range other.intervals() {
+			start, end := max(a[0], b[0]), min(a[1], b[1])
+			if start < end {
+				parts = append(parts, [2]time.Duration{start, end})
+			}
+		}
+	}
+
+	if len(parts) == 0 {
+		return DayTimeRange{}, false
+	}
+
+	slices.SortFunc(parts, func(a, b [2]time.Duration) int {
+		return cmp.Compare(a[0], b[0])
+	})
+
+	// The parts touching midnight on both sides are joined into a wrapping range.
+	if last := len(parts) - 1; last > 0 && parts[0][0] == 0 && parts[last][1] == Day {
+		parts[last][1] = parts[0][1]
+		parts = parts[1:]
+	}
+
+	return DayTimeRange{Start: fromDuration(parts[0][0]), End: fromDuration(parts[0][1] % Day)}, true
+}
+
+// intervals splits the range into intervals not crossing midnight,
+// as durations since midnight. An empty range has no intervals.
+func (r DayTimeRange) intervals() [][2]time.Duration {
+	start, end := r.Start.Duration(), r.End.Duration()
+
+	switch {
+	case start < end:
+		return [][2]time.Duration{{start, end}}
+	case start > end && end == 0:
+		return [][2]time.Duration{{start, Day}}
+	case start > end:
+		return [][2]time.Duration{{0, end}, {start, Day}}
+	}
+
+	return nil
+}
+
+// Iterate returns the daytimes from the start to the end inclusive with the
+// step, e.g. 09:00, 09:15, ..., 10:00. A wrapping range continues past
+// midnight. A step reaching the end returns the end itself, so a range ending
+// at 24:00 ends with 24:00. A non-positive step returns nil.
+func (r DayTimeRange) Iterate(step time.Duration) []DayTime {
+	if step <= 0 {
+		return nil
+	}
+
+	length := r.Duration()
+	values := make([]DayTime, 0, length/step+1)
+
+	for offset := time.Duration(0); offset <= length; offset += step {
+		value := r.Start.Add(offset)
+		if offset == length {
+			value = r.End
+		}
+
+		values = append(values, value)
+	}
+
+	return values
+}
+
+// Split chops the range into consecutive slots of the given length from the
+// start, e.g. 09:00-12:00 into 09:00-10:00, 10:00-11:00 and 11:00-12:00.
+// A final slot shorter than the length is dropped. A non-positive length
+// returns nil.
+func (r DayTimeRange) Split(slot time.Duration) []DayTimeRange {
+	if slot <= 0 {
+		return nil
+	}
+
+	length := r.Duration()
+	slots := make([]DayTimeRange, 0, length/slot)
+
+	for offset := time.Duration(0); offset+slot <= length; offset += slot {
+		end := r.Start.Add(offset + slot)
+		if offset+slot == length {
+			end = r.End
+		}
+
+		slots = append(slots, DayTimeRange{Start: r.Start.Add(offset), End: end})
+	}
+
+	return slots
+}
+
+// MergeRanges coalesces overlapping and adjacent ranges into the minimal set
+// of ranges covering the same time, ordered by the start. Ranges crossing
+// midnight are split at midnight before merging, the parts touching midnight
+// on both sides are joined back into a wrapping range, which is the last one.
+// Coverage of the whole day is returned as 00:00-24:00.
+func MergeRanges(ranges []DayTimeRange) []DayTimeRange {
+	return rangesFromIntervals(mergeIntervals(ranges))
+}
+
+// ComplementRanges returns the gaps of the day not covered by the ranges,
+// e.g. the closed hours for the open ones, ordered as in MergeRanges.
+// No ranges give the whole day 00:00-24:00, the whole day gives no ranges.
+func ComplementRanges(ranges []DayTimeRange) []DayTimeRange {
+	var gaps [][2]time.Duration
+
+	cursor := time.Duration(0)
+	for _, interval := range mergeIntervals(ranges) {
+		if interval[0] > cursor {
+			gaps = append(gaps, [2]time.Duration{cursor, interval[0]})
+		}
+		cursor = interval[1]
+	}
+	if cursor < Day {
+		gaps = append(gaps, [2]time.Duration{cursor, Day})
+	}
+
+	return rangesFromIntervals(gaps)
+}
+
+// rangesFromIntervals converts sorted disjoint intervals to ranges, the
+// intervals touching midnight on both sides are joined into a wrapping range.
+func rangesFromIntervals(intervals [][2]time.Duration) []DayTimeRange {
+	if last := len(intervals) - 1; last > 0 && intervals[0][0] == 0 && intervals[last][1] == Day {
+		intervals[last][1] = intervals[0][1]
+		intervals = intervals[1:]
+	}
+
+	ranges := make([]DayTimeRange, 0, len(intervals))
+	for _, interval := range intervals {
+		ranges = append(ranges, DayTimeRange{Start: fromDuration(interval[0]), End: fromDuration(interval[1])})
+	}
+
+	return ranges
+}
+
+// mergeIntervals splits the ranges into intervals not crossing midnight,
+// sorts them and coalesces overlapping and adjacent ones.
+func mergeIntervals(ranges []DayTimeRange) [][2]time.Duration {
+	var intervals [][2]time.Duration
+	for _, r := range ranges {
+		intervals = append(intervals, r.intervals()...)
+	}
+
+	slices.SortFunc(intervals, func(a, b [2]time.Duration) int {
+		return cmp.Compare(a[0], b[0])
+	})
+
+	merged := make([][2]time.Duration, 0, len(intervals))
+	for _, interval := range intervals {
+		if last := len(merged) - 1; last >= 0 && interval[0] <= merged[last][1] {
+			merged[last][1] = max(merged[last][1], interval[1])
+
+			continue
+		}
+
+		merged = append(merged, interval)
+	}
+
+	return merged
+}
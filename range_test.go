@@ -0,0 +1,735 @@
+package daytime
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseRange(t *testing.T) {
+	t.Parallel()
+
+	type expectedResult struct {
+		value DayTimeRange
+		err   error
+	}
+
+	tests := []struct {
+		name           string
+		value          string
+		expectedResult expectedResult
+	}{
+		{
+			name:  "Checking standard work",
+			value: "09:00-17:00",
+			expectedResult: expectedResult{
+				value: DayTimeRange{Start: DayTime{hour: 9}, End: DayTime{hour: 17}},
+			},
+		},
+		{
+			name:  "Checking the processing of whitespace",
+			value: " 09:00 - 17:30:15 ",
+			expectedResult: expectedResult{
+				value: DayTimeRange{Start: DayTime{hour: 9}, End: DayTime{hour: 17, minute: 30, second: 15}},
+			},
+		},
+		{
+			name:  "Checking the processing of the end of the day",
+			value: "22:00-24:00",
+			expectedResult: expectedResult{
+				value: DayTimeRange{Start: DayTime{hour: 22}, End: DayTime{hour: 24}},
+			},
+		},
+		{
+			name:  "Checking the processing of the end of the day at the start",
+			value: "24:00-02:00",
+			expectedResult: expectedResult{
+				err: ErrInvalid,
+			},
+		},
+		{
+			name:  "Checking the processing of a missing dash",
+			value: "09:00 17:00",
+			expectedResult: expectedResult{
+				err: ErrInvalid,
+			},
+		},
+		{
+			name:  "Checking the processing of an invalid endpoint",
+			value: "09:00-25:00",
+			expectedResult: expectedResult{
+				err: ErrInvalid,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+
+			value, err := ParseRange(test.value)
+
+			assert.EqualValues(tt, test.expectedResult.value, value)
+			assert.ErrorIs(tt, err, test.expectedResult.err)
+		})
+	}
+}
+
+func TestRangeString(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		value          DayTimeRange
+		expectedResult string
+	}{
+		{
+			name:           "Checking standard work",
+			value:          DayTimeRange{Start: DayTime{hour: 9}, End: DayTime{hour: 17}},
+			expectedResult: "09:00-17:00",
+		},
+		{
+			name:           "Checking the processing of seconds",
+			value:          DayTimeRange{Start: DayTime{hour: 22, second: 30}, End: DayTime{hour: 2}},
+			expectedResult: "22:00:30-02:00",
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+
+			assert.EqualValues(tt, test.expectedResult, test.value.String())
+		})
+	}
+}
+
+func TestRangeJSONRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	type schedule struct {
+		Hours DayTimeRange `json:"hours"`
+	}
+	source := schedule{
+		Hours: DayTimeRange{Start: DayTime{hour: 9}, End: DayTime{hour: 17, minute: 30}},
+	}
+
+	data, err := json.Marshal(source)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"hours":"09:00-17:30"}`, string(data))
+
+	target := schedule{}
+	err = json.Unmarshal(data, &target)
+	assert.NoError(t, err)
+	assert.EqualValues(t, source, target)
+}
+
+func TestRangeUnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	type expectedResult struct {
+		value DayTimeRange
+		err   error
+	}
+	tests := []struct {
+		name           string
+		data           string
+		expectedResult expectedResult
+	}{
+		{
+			name: "Checking standard work",
+			data: `"22:00-24:00"`,
+			expectedResult: expectedResult{
+				value: DayTimeRange{Start: DayTime{hour: 22}, End: DayTime{hour: 24}},
+			},
+		},
+		{
+			name: "Checking to process null",
+			data: `null`,
+			expectedResult: expectedResult{
+				value: DayTimeRange{},
+			},
+		},
+		{
+			name: "Checking the processing of an unquoted token",
+			data: `900`,
+			expectedResult: expectedResult{
+				value: DayTimeRange{Start: DayTime{hour: 1}, End: DayTime{hour: 2}},
+				err:   ErrInvalid,
+			},
+		},
+		{
+			name: "Checking to process parse error",
+			data: `"09:00"`,
+			expectedResult: expectedResult{
+				value: DayTimeRange{Start: DayTime{hour: 1}, End: DayTime{hour: 2}},
+				err:   ErrInvalid,
+			},
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+
+			value := DayTimeRange{Start: DayTime{hour: 1}, End: DayTime{hour: 2}}
+			err := value.UnmarshalJSON([]byte(test.data))
+			assert.EqualValues(tt, test.expectedResult.value, value)
+			assert.ErrorIs(tt, err, test.expectedResult.err)
+		})
+	}
+
+	var value *DayTimeRange
+	assert.ErrorIs(t, value.UnmarshalJSON([]byte(`"09:00-17:00"`)), ErrObjIsNil)
+}
+
+func TestRangeContains(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		value     DayTime
+		inclusive bool
+	}
+
+	hours := DayTimeRange{Start: DayTime{hour: 9}, End: DayTime{hour: 17}}
+	night := DayTimeRange{Start: DayTime{hour: 22}, End: DayTime{hour: 2}}
+
+	tests := []struct {
+		name           string
+		daytimeRange   DayTimeRange
+		args           args
+		expectedResult bool
+	}{
+		{
+			name:           "Checking standard work",
+			daytimeRange:   hours,
+			args:           args{value: DayTime{hour: 12}},
+			expectedResult: true,
+		},
+		{
+			name:           "Checking the processing of a time outside",
+			daytimeRange:   hours,
+			args:           args{value: DayTime{hour: 18}},
+			expectedResult: false,
+		},
+		{
+			name:           "Checking the processing of a wrapping range before midnight",
+			daytimeRange:   night,
+			args:           args{value: DayTime{hour: 23}},
+			expectedResult: true,
+		},
+		{
+			name:           "Checking the processing of a wrapping range after midnight",
+			daytimeRange:   night,
+			args:           args{value: DayTime{hour: 1}},
+			expectedResult: true,
+		},
+		{
+			name:           "Checking the processing of a wrapping range outside",
+			daytimeRange:   night,
+			args:           args{value: DayTime{hour: 12}},
+			expectedResult: false,
+		},
+		{
+			name:           "Checking the processing of the start exclusive",
+			daytimeRange:   hours,
+			args:           args{value: DayTime{hour: 9}},
+			expectedResult: false,
+		},
+		{
+			name:           "Checking the processing of the start inclusive",
+			daytimeRange:   hours,
+			args:           args{value: DayTime{hour: 9}, inclusive: true},
+			expectedResult: true,
+		},
+		{
+			name:           "Checking the processing of the end exclusive",
+			daytimeRange:   night,
+			args:           args{value: DayTime{hour: 2}},
+			expectedResult: false,
+		},
+		{
+			name:           "Checking the processing of the end inclusive",
+			daytimeRange:   night,
+			args:           args{value: DayTime{hour: 2}, inclusive: true},
+			expectedResult: true,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+
+			assert.EqualValues(tt, test.expectedResult, test.daytimeRange.Contains(test.args.value, test.args.inclusive))
+		})
+	}
+}
+
+func TestRangeDuration(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		daytimeRange   DayTimeRange
+		expectedResult time.Duration
+	}{
+		{
+			name:           "Checking standard work",
+			daytimeRange:   DayTimeRange{Start: DayTime{hour: 9}, End: DayTime{hour: 17}},
+			expectedResult: 8 * time.Hour,
+		},
+		{
+			name:           "Checking the processing of a wrapping range",
+			daytimeRange:   DayTimeRange{Start: DayTime{hour: 22}, End: DayTime{hour: 2}},
+			expectedResult: 4 * time.Hour,
+		},
+		{
+			name:           "Checking the processing of the end of the day",
+			daytimeRange:   DayTimeRange{Start: DayTime{hour: 22}, End: DayTime{hour: 24}},
+			expectedResult: 2 * time.Hour,
+		},
+		{
+			name:           "Checking the processing of an empty range",
+			daytimeRange:   DayTimeRange{Start: DayTime{hour: 9}, End: DayTime{hour: 9}},
+			expectedResult: 0,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+
+			assert.EqualValues(tt, test.expectedResult, test.daytimeRange.Duration())
+		})
+	}
+}
+
+func TestRangeOverlapsAndIntersect(t *testing.T) {
+	t.Parallel()
+
+	type expectedResult struct {
+		value DayTimeRange
+		ok    bool
+	}
+
+	tests := []struct {
+		name           string
+		daytimeRange   DayTimeRange
+		other          DayTimeRange
+		expectedResult expectedResult
+	}{
+		{
+			name:         "Checking standard work",
+			daytimeRange: DayTimeRange{Start: DayTime{hour: 9}, End: DayTime{hour: 12}},
+			other:        DayTimeRange{Start: DayTime{hour: 11}, End: DayTime{hour: 17}},
+			expectedResult: expectedResult{
+				value: DayTimeRange{Start: DayTime{hour: 11}, End: DayTime{hour: 12}},
+				ok:    true,
+			},
+		},
+		{
+			name:         "Checking the processing of disjoint ranges",
+			daytimeRange: DayTimeRange{Start: DayTime{hour: 9}, End: DayTime{hour: 12}},
+			other:        DayTimeRange{Start: DayTime{hour: 13}, End: DayTime{hour: 17}},
+		},
+		{
+			name:         "Checking the processing of touching ranges",
+			daytimeRange: DayTimeRange{Start: DayTime{hour: 9}, End: DayTime{hour: 12}},
+			other:        DayTimeRange{Start: DayTime{hour: 12}, End: DayTime{hour: 17}},
+		},
+		{
+			name:         "Checking the processing of a nested range",
+			daytimeRange: DayTimeRange{Start: DayTime{hour: 9}, End: DayTime{hour: 17}},
+			other:        DayTimeRange{Start: DayTime{hour: 12}, End: DayTime{hour: 13, minute: 30}},
+			expectedResult: expectedResult{
+				value: DayTimeRange{Start: DayTime{hour: 12}, End: DayTime{hour: 13, minute: 30}},
+				ok:    true,
+			},
+		},
+		{
+			name:         "Checking the processing of a wrapping range after midnight",
+			daytimeRange: DayTimeRange{Start: DayTime{hour: 22}, End: DayTime{hour: 2}},
+			other:        DayTimeRange{Start: DayTime{hour: 1}, End: DayTime{hour: 3}},
+			expectedResult: expectedResult{
+				value: DayTimeRange{Start: DayTime{hour: 1}, End: DayTime{hour: 2}},
+				ok:    true,
+			},
+		},
+		{
+			name:         "Checking the processing of a wrapping range before midnight",
+			daytimeRange: DayTimeRange{Start: DayTime{hour: 22}, End: DayTime{hour: 2}},
+			other:        DayTimeRange{Start: DayTime{hour: 20}, End: DayTime{hour: 23}},
+			expectedResult: expectedResult{
+				value: DayTimeRange{Start: DayTime{hour: 22}, End: DayTime{hour: 23}},
+				ok:    true,
+			},
+		},
+		{
+			name:         "Checking the processing of a wrapping range disjoint with a normal one",
+			daytimeRange: DayTimeRange{Start: DayTime{hour: 22}, End: DayTime{hour: 2}},
+			other:        DayTimeRange{Start: DayTime{hour: 9}, End: DayTime{hour: 17}},
+		},
+		{
+			name:         "Checking the processing of two wrapping ranges",
+			daytimeRange: DayTimeRange{Start: DayTime{hour: 22}, End: DayTime{hour: 2}},
+			other:        DayTimeRange{Start: DayTime{hour: 23}, End: DayTime{hour: 3}},
+			expectedResult: expectedResult{
+				value: DayTimeRange{Start: DayTime{hour: 23}, End: DayTime{hour: 2}},
+				ok:    true,
+			},
+		},
+		{
+			name:         "Checking the processing of several common parts",
+			daytimeRange: DayTimeRange{Start: DayTime{hour: 22}, End: DayTime{hour: 6}},
+			other:        DayTimeRange{Start: DayTime{hour: 5}, End: DayTime{hour: 23}},
+			expectedResult: expectedResult{
+				value: DayTimeRange{Start: DayTime{hour: 5}, End: DayTime{hour: 6}},
+				ok:    true,
+			},
+		},
+		{
+			name:         "Checking the processing of a range ending at midnight",
+			daytimeRange: DayTimeRange{Start: DayTime{hour: 22}, End: DayTime{}},
+			other:        DayTimeRange{Start: DayTime{hour: 23}, End: DayTime{hour: 1}},
+			expectedResult: expectedResult{
+				value: DayTimeRange{Start: DayTime{hour: 23}, End: DayTime{}},
+				ok:    true,
+			},
+		},
+		{
+			name:         "Checking the processing of an empty range",
+			daytimeRange: DayTimeRange{Start: DayTime{hour: 10}, End: DayTime{hour: 10}},
+			other:        DayTimeRange{Start: DayTime{hour: 9}, End: DayTime{hour: 17}},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+
+			value, ok := test.daytimeRange.Intersect(test.other)
+
+			assert.EqualValues(tt, test.expectedResult.value, value)
+			assert.EqualValues(tt, test.expectedResult.ok, ok)
+			assert.EqualValues(tt, test.expectedResult.ok, test.daytimeRange.Overlaps(test.other))
+			assert.EqualValues(tt, test.expectedResult.ok, test.other.Overlaps(test.daytimeRange))
+		})
+	}
+}
+
+func TestRangeIterate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		daytimeRange   DayTimeRange
+		step           time.Duration
+		expectedResult []DayTime
+	}{
+		{
+			name:         "Checking standard work",
+			daytimeRange: DayTimeRange{Start: DayTime{hour: 9}, End: DayTime{hour: 10}},
+			step:         15 * time.Minute,
+			expectedResult: []DayTime{
+				{hour: 9},
+				{hour: 9, minute: 15},
+				{hour: 9, minute: 30},
+				{hour: 9, minute: 45},
+				{hour: 10},
+			},
+		},
+		{
+			name:         "Checking the processing of a step not reaching the end",
+			daytimeRange: DayTimeRange{Start: DayTime{hour: 9}, End: DayTime{hour: 10}},
+			step:         25 * time.Minute,
+			expectedResult: []DayTime{
+				{hour: 9},
+				{hour: 9, minute: 25},
+				{hour: 9, minute: 50},
+			},
+		},
+		{
+			name:         "Checking the processing of a wrapping range",
+			daytimeRange: DayTimeRange{Start: DayTime{hour: 23}, End: DayTime{hour: 1}},
+			step:         time.Hour,
+			expectedResult: []DayTime{
+				{hour: 23},
+				{},
+				{hour: 1},
+			},
+		},
+		{
+			name:         "Checking the processing of the end of the day",
+			daytimeRange: DayTimeRange{Start: DayTime{hour: 23}, End: DayTime{hour: 24}},
+			step:         30 * time.Minute,
+			expectedResult: []DayTime{
+				{hour: 23},
+				{hour: 23, minute: 30},
+				{hour: 24},
+			},
+		},
+		{
+			name:           "Checking the processing of a zero step",
+			daytimeRange:   DayTimeRange{Start: DayTime{hour: 9}, End: DayTime{hour: 10}},
+			step:           0,
+			expectedResult: nil,
+		},
+		{
+			name:           "Checking the processing of a negative step",
+			daytimeRange:   DayTimeRange{Start: DayTime{hour: 9}, End: DayTime{hour: 10}},
+			step:           -time.Minute,
+			expectedResult: nil,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+
+			assert.EqualValues(tt, test.expectedResult, test.daytimeRange.Iterate(test.step))
+		})
+	}
+}
+
+func TestRangeSplit(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		daytimeRange   DayTimeRange
+		slot           time.Duration
+		expectedResult []DayTimeRange
+	}{
+		{
+			name:         "Checking standard work",
+			daytimeRange: DayTimeRange{Start: DayTime{hour: 9}, End: DayTime{hour: 12}},
+			slot:         time.Hour,
+			expectedResult: []DayTimeRange{
+				{Start: DayTime{hour: 9}, End: DayTime{hour: 10}},
+				{Start: DayTime{hour: 10}, End: DayTime{hour: 11}},
+				{Start: DayTime{hour: 11}, End: DayTime{hour: 12}},
+			},
+		},
+		{
+			name:         "Checking the processing of a remainder",
+			daytimeRange: DayTimeRange{Start: DayTime{hour: 9}, End: DayTime{hour: 10, minute: 45}},
+			slot:         30 * time.Minute,
+			expectedResult: []DayTimeRange{
+				{Start: DayTime{hour: 9}, End: DayTime{hour: 9, minute: 30}},
+				{Start: DayTime{hour: 9, minute: 30}, End: DayTime{hour: 10}},
+				{Start: DayTime{hour: 10}, End: DayTime{hour: 10, minute: 30}},
+			},
+		},
+		{
+			name:         "Checking the processing of a wrapping range",
+			daytimeRange: DayTimeRange{Start: DayTime{hour: 23}, End: DayTime{hour: 1}},
+			slot:         time.Hour,
+			expectedResult: []DayTimeRange{
+				{Start: DayTime{hour: 23}, End: DayTime{}},
+				{Start: DayTime{}, End: DayTime{hour: 1}},
+			},
+		},
+		{
+			name:         "Checking the processing of the end of the day",
+			daytimeRange: DayTimeRange{Start: DayTime{hour: 22}, End: DayTime{hour: 24}},
+			slot:         time.Hour,
+			expectedResult: []DayTimeRange{
+				{Start: DayTime{hour: 22}, End: DayTime{hour: 23}},
+				{Start: DayTime{hour: 23}, End: DayTime{hour: 24}},
+			},
+		},
+		{
+			name:           "Checking the processing of a slot longer than the range",
+			daytimeRange:   DayTimeRange{Start: DayTime{hour: 9}, End: DayTime{hour: 10}},
+			slot:           2 * time.Hour,
+			expectedResult: []DayTimeRange{},
+		},
+		{
+			name:           "Checking the processing of a non-positive slot",
+			daytimeRange:   DayTimeRange{Start: DayTime{hour: 9}, End: DayTime{hour: 10}},
+			slot:           0,
+			expectedResult: nil,
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+
+			assert.EqualValues(tt, test.expectedResult, test.daytimeRange.Split(test.slot))
+		})
+	}
+}
+
+func TestMergeRanges(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		ranges         []DayTimeRange
+		expectedResult []DayTimeRange
+	}{
+		{
+			name: "Checking disjoint ranges",
+			ranges: []DayTimeRange{
+				{Start: DayTime{hour: 14}, End: DayTime{hour: 17}},
+				{Start: DayTime{hour: 9}, End: DayTime{hour: 12}},
+			},
+			expectedResult: []DayTimeRange{
+				{Start: DayTime{hour: 9}, End: DayTime{hour: 12}},
+				{Start: DayTime{hour: 14}, End: DayTime{hour: 17}},
+			},
+		},
+		{
+			name: "Checking overlapping ranges",
+			ranges: []DayTimeRange{
+				{Start: DayTime{hour: 9}, End: DayTime{hour: 12}},
+				{Start: DayTime{hour: 11}, End: DayTime{hour: 14}},
+				{Start: DayTime{hour: 10}, End: DayTime{hour: 11}},
+			},
+			expectedResult: []DayTimeRange{
+				{Start: DayTime{hour: 9}, End: DayTime{hour: 14}},
+			},
+		},
+		{
+			name: "Checking adjacent ranges",
+			ranges: []DayTimeRange{
+				{Start: DayTime{hour: 9}, End: DayTime{hour: 12}},
+				{Start: DayTime{hour: 12}, End: DayTime{hour: 13}},
+			},
+			expectedResult: []DayTimeRange{
+				{Start: DayTime{hour: 9}, End: DayTime{hour: 13}},
+			},
+		},
+		{
+			name: "Checking wrapping ranges",
+			ranges: []DayTimeRange{
+				{Start: DayTime{hour: 22}, End: DayTime{hour: 2}},
+				{Start: DayTime{hour: 1}, End: DayTime{hour: 3}},
+				{Start: DayTime{hour: 9}, End: DayTime{hour: 12}},
+				{Start: DayTime{hour: 21}, End: DayTime{hour: 23}},
+			},
+			expectedResult: []DayTimeRange{
+				{Start: DayTime{hour: 9}, End: DayTime{hour: 12}},
+				{Start: DayTime{hour: 21}, End: DayTime{hour: 3}},
+			},
+		},
+		{
+			name: "Checking a range ending at midnight",
+			ranges: []DayTimeRange{
+				{Start: DayTime{hour: 22}, End: DayTime{}},
+				{Start: DayTime{hour: 20}, End: DayTime{hour: 23}},
+			},
+			expectedResult: []DayTimeRange{
+				{Start: DayTime{hour: 20}, End: DayTime{hour: 24}},
+			},
+		},
+		{
+			name: "Checking the whole day",
+			ranges: []DayTimeRange{
+				{Start: DayTime{hour: 12}, End: DayTime{hour: 1}},
+				{Start: DayTime{}, End: DayTime{hour: 13}},
+			},
+			expectedResult: []DayTimeRange{
+				{Start: DayTime{}, End: DayTime{hour: 24}},
+			},
+		},
+		{
+			name: "Checking empty ranges",
+			ranges: []DayTimeRange{
+				{Start: DayTime{hour: 9}, End: DayTime{hour: 9}},
+			},
+			expectedResult: []DayTimeRange{},
+		},
+		{
+			name:           "Checking to process nil",
+			ranges:         nil,
+			expectedResult: []DayTimeRange{},
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+
+			assert.EqualValues(tt, test.expectedResult, MergeRanges(test.ranges))
+		})
+	}
+}
+
+func TestComplementRanges(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		ranges         []DayTimeRange
+		expectedResult []DayTimeRange
+	}{
+		{
+			name: "Checking a midday range",
+			ranges: []DayTimeRange{
+				{Start: DayTime{hour: 9}, End: DayTime{hour: 17}},
+			},
+			expectedResult: []DayTimeRange{
+				{Start: DayTime{hour: 17}, End: DayTime{hour: 9}},
+			},
+		},
+		{
+			name: "Checking several ranges",
+			ranges: []DayTimeRange{
+				{Start: DayTime{hour: 13}, End: DayTime{hour: 17}},
+				{Start: DayTime{}, End: DayTime{hour: 12}},
+			},
+			expectedResult: []DayTimeRange{
+				{Start: DayTime{hour: 12}, End: DayTime{hour: 13}},
+				{Start: DayTime{hour: 17}, End: DayTime{hour: 24}},
+			},
+		},
+		{
+			name: "Checking a wrapping range",
+			ranges: []DayTimeRange{
+				{Start: DayTime{hour: 22}, End: DayTime{hour: 6}},
+			},
+			expectedResult: []DayTimeRange{
+				{Start: DayTime{hour: 6}, End: DayTime{hour: 22}},
+			},
+		},
+		{
+			name: "Checking the whole day",
+			ranges: []DayTimeRange{
+				{Start: DayTime{hour: 12}, End: DayTime{hour: 1}},
+				{Start: DayTime{}, End: DayTime{hour: 13}},
+			},
+			expectedResult: []DayTimeRange{},
+		},
+		{
+			name:   "Checking to process nil",
+			ranges: nil,
+			expectedResult: []DayTimeRange{
+				{Start: DayTime{}, End: DayTime{hour: 24}},
+			},
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+
+			assert.EqualValues(tt, test.expectedResult, ComplementRanges(test.ranges))
+		})
+	}
+}
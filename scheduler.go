@@ -0,0 +1,103 @@
+package daytime
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Scheduler invokes a callback once a day at a daytime.
+type Scheduler struct {
+	daytime DayTime
+	fn      func(time.Time)
+
+	// now and timer are the clock of the scheduler, they are replaced in tests.
+	now   func() time.Time
+	timer func(d time.Duration) (<-chan time.Time, func() bool)
+
+	mu     sync.Mutex
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewDailyScheduler create a scheduler invoking fn every day at the daytime.
+// The callback receives the time the scheduler fired at.
+func NewDailyScheduler(t DayTime, fn func(time.Time)) *Scheduler {
+	return &Scheduler{
+		daytime: t,
+		fn:      fn,
+		now:     time.Now,
+		timer:   newTimer,
+	}
+}
+
+// Start runs the scheduler in the background until the context is cancelled
+// or Stop is called. Starting a running scheduler does nothing, a scheduler
+// stopped either way can be started again.
+func (s *Scheduler) Start(ctx context.Context) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.done != nil {
+		return
+	}
+
+	ctx, s.cancel = context.WithCancel(ctx)
+	s.done = make(chan struct{})
+
+	go s.run(ctx, s.done)
+}
+
+// Stop stops the scheduler and waits until a running callback returns.
+// Stop must not be called from the callback, it would wait for itself,
+// cancel the context passed to Start there instead.
+func (s *Scheduler) Stop() {
+	s.mu.Lock()
+	cancel, done := s.cancel, s.done
+	s.cancel, s.done = nil, nil
+	s.mu.Unlock()
+
+	if cancel == nil {
+		return
+	}
+
+	cancel()
+	<-done
+}
+
+func (s *Scheduler) run(ctx context.Context, done chan struct{}) {
+	defer close(done)
+	defer s.release(done)
+
+	for {
+		now := s.now()
+		c, stop := s.timer(s.daytime.NextOccurrence(now).Sub(now))
+
+		select {
+		case <-ctx.Done():
+			stop()
+
+			return
+		case fired := <-c:
+			s.fn(fired)
+		}
+	}
+}
+
+// release forgets the run if it is still the current one, e.g. when the
+// parent context is cancelled, so the scheduler can be started again.
+func (s *Scheduler) release(done chan struct{}) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.done == done {
+		s.cancel()
+		s.cancel, s.done = nil, nil
+	}
+}
+
+func newTimer(d time.Duration) (<-chan time.Time, func() bool) {
+	timer := time.NewTimer(d)
+
+	return timer.C, timer.Stop
+}
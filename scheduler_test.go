@@ -0,0 +1,180 @@
+package daytime
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeClock fires the first timer immediately and never fires the others.
+type fakeClock struct {
+	mu        sync.Mutex
+	now       time.Time
+	durations []time.Duration
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.now
+}
+
+func (c *fakeClock) Timer(d time.Duration) (<-chan time.Time, func() bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.durations = append(c.durations, d)
+	if len(c.durations) > 1 {
+		return nil, func() bool { return true }
+	}
+
+	c.now = c.now.Add(d)
+	fired := make(chan time.Time, 1)
+	fired <- c.now
+
+	return fired, func() bool { return false }
+}
+
+func (c *fakeClock) Durations() []time.Duration {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.durations
+}
+
+func TestScheduler(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Checking to fire and stop", func(tt *testing.T) {
+		tt.Parallel()
+
+		clock := &fakeClock{
+			now: time.Date(2024, time.March, 15, 12, 0, 0, 0, time.UTC),
+		}
+		fired := make(chan time.Time, 1)
+		scheduler := NewDailyScheduler(DayTime{hour: 9}, func(t time.Time) {
+			fired <- t
+		})
+		scheduler.now = clock.Now
+		scheduler.timer = clock.Timer
+
+		scheduler.Start(context.Background())
+		select {
+		case value := <-fired:
+			assert.EqualValues(tt, time.Date(2024, time.March, 16, 9, 0, 0, 0, time.UTC), value)
+		case <-time.After(time.Second):
+			assert.Fail(tt, "scheduler has not fired")
+		}
+		scheduler.Stop()
+
+		assert.EqualValues(tt, []time.Duration{21 * time.Hour, Day}, clock.Durations())
+		assert.Empty(tt, fired)
+	})
+	t.Run("Checking to fire across the daylight saving time transition", func(tt *testing.T) {
+		tt.Parallel()
+
+		loc, err := time.LoadLocation("America/New_York")
+		assert.NoError(tt, err)
+
+		clock := &fakeClock{
+			now: time.Date(2026, time.March, 7, 10, 0, 0, 0, loc),
+		}
+		fired := make(chan time.Time, 1)
+		scheduler := NewDailyScheduler(DayTime{hour: 9}, func(t time.Time) {
+			fired <- t
+		})
+		scheduler.now = clock.Now
+		scheduler.timer = clock.Timer
+
+		scheduler.Start(context.Background())
+		select {
+		case value := <-fired:
+			assert.EqualValues(tt, 9, value.Hour())
+		case <-time.After(time.Second):
+			assert.Fail(tt, "scheduler has not fired")
+		}
+		scheduler.Stop()
+
+		assert.EqualValues(tt, []time.Duration{22 * time.Hour, Day}, clock.Durations())
+	})
+	t.Run("Checking to stop on the context cancellation", func(tt *testing.T) {
+		tt.Parallel()
+
+		clock := &fakeClock{
+			now:       time.Date(2024, time.March, 15, 12, 0, 0, 0, time.UTC),
+			durations: []time.Duration{0},
+		}
+		scheduler := NewDailyScheduler(DayTime{hour: 9}, func(t time.Time) {
+			assert.Fail(tt, "scheduler has fired")
+		})
+		scheduler.now = clock.Now
+		scheduler.timer = clock.Timer
+
+		ctx, cancel := context.WithCancel(context.Background())
+		scheduler.Start(ctx)
+		done := scheduler.done
+		cancel()
+
+		select {
+		case <-done:
+		case <-time.After(time.Second):
+			assert.Fail(tt, "scheduler has not stopped")
+		}
+		scheduler.Stop()
+	})
+	t.Run("Checking to cancel from the callback", func(tt *testing.T) {
+		tt.Parallel()
+
+		clock := &fakeClock{
+			now: time.Date(2024, time.March, 15, 12, 0, 0, 0, time.UTC),
+		}
+		ctx, cancel := context.WithCancel(context.Background())
+		scheduler := NewDailyScheduler(DayTime{hour: 9}, func(t time.Time) {
+			cancel()
+		})
+		scheduler.now = clock.Now
+		scheduler.timer = clock.Timer
+
+		scheduler.Start(ctx)
+		<-ctx.Done()
+
+		stopped := make(chan struct{})
+		go func() {
+			scheduler.Stop()
+			close(stopped)
+		}()
+		select {
+		case <-stopped:
+		case <-time.After(time.Second):
+			assert.Fail(tt, "scheduler has not stopped")
+		}
+	})
+	t.Run("Checking to restart after the context cancellation", func(tt *testing.T) {
+		tt.Parallel()
+
+		clock := &fakeClock{
+			now:       time.Date(2024, time.March, 15, 12, 0, 0, 0, time.UTC),
+			durations: []time.Duration{0},
+		}
+		scheduler := NewDailyScheduler(DayTime{hour: 9}, func(t time.Time) {})
+		scheduler.now = clock.Now
+		scheduler.timer = clock.Timer
+
+		ctx, cancel := context.WithCancel(context.Background())
+		scheduler.Start(ctx)
+		done := scheduler.done
+		cancel()
+		<-done
+
+		scheduler.Start(context.Background())
+		scheduler.mu.Lock()
+		restarted := scheduler.done != nil && scheduler.done != done
+		scheduler.mu.Unlock()
+		assert.True(tt, restarted)
+		scheduler.Stop()
+	})
+}
@@ -0,0 +1,101 @@
+package daytime
+
+import (
+	"time"
+)
+
+// DayTimeSet is a set of unique daytimes keyed on TotalSeconds, so daytimes
+// differing only in fractional seconds are the same element and the last
+// added one is kept.
+// The zero value is an empty set ready to use.
+type DayTimeSet struct {
+	items map[int]DayTime
+}
+
+// NewDayTimeSet create a new set of the daytimes.
+func NewDayTimeSet(times ...DayTime) DayTimeSet {
+	set := DayTimeSet{}
+	for _, t := range times {
+		set.Add(t)
+	}
+
+	return set
+}
+
+// Add adds the daytime to the set.
+func (s *DayTimeSet) Add(t DayTime) {
+	if s.items == nil {
+		s.items = make(map[int]DayTime)
+	}
+
+	s.items[t.TotalSeconds()] = t
+}
+
+// Remove removes the daytime from the set.
+func (s *DayTimeSet) Remove(t DayTime) {
+	delete(s.items, t.TotalSeconds())
+}
+
+// Contains reports whether the daytime is in the set.
+func (s DayTimeSet) Contains(t DayTime) bool {
+	_, ok := s.items[t.TotalSeconds()]
+
+	return ok
+}
+
+// Len returns the number of daytimes in the set.
+func (s DayTimeSet) Len() int {
+	return len(s.items)
+}
+
+// Sorted returns the daytimes of the set from the earliest to the latest.
+func (s DayTimeSet) Sorted() []DayTime {
+	times := make(DayTimes, 0, len(s.items))
+	for _, t := range s.items {
+		times = append(times, t)
+	}
+	times.Sort()
+
+	return times
+}
+
+// Nearest returns the daytime of the set closest to the target and whether
+// the set is not empty. Of equally close daytimes the earliest one is
+// returned.
+func (s DayTimeSet) Nearest(target DayTime) (DayTime, bool) {
+	return s.nearest(target, false)
+}
+
+// NearestWrap is like Nearest but measures the distance around midnight,
+// so 00:10 is nearer to 23:50 than 23:00 is.
+func (s DayTimeSet) NearestWrap(target DayTime) (DayTime, bool) {
+	return s.nearest(target, true)
+}
+
+func (s DayTimeSet) nearest(target DayTime, wrap bool) (DayTime, bool) {
+	result, found := DayTime{}, false
+	best := time.Duration(0)
+
+	for _, t := range s.Sorted() {
+		distance := t.Sub(&target)
+		if distance < 0 {
+			distance = -distance
+		}
+		if wrap {
+			distance = min(distance, Day-distance)
+		}
+
+		if !found || distance < best {
+			result, found, best = t, true, distance
+		}
+	}
+
+	return result, found
+}
+
+// SnapToNearest returns the allowed daytime closest to the clock of ts,
+// measuring around midnight, and whether the list is not empty. Of equally
+// close daytimes the earliest one is returned.
+func SnapToNearest(ts time.Time, allowed []DayTime) (DayTime, bool) {
+	return NewDayTimeSet(allowed...).NearestWrap(FromTime(ts))
+}
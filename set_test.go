@@ -0,0 +1,282 @@
+package daytime
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDayTimeSet(t *testing.T) {
+	t.Parallel()
+
+	type expectedResult struct {
+		sorted   []DayTime
+		contains []DayTime
+		missing  []DayTime
+	}
+	tests := []struct {
+		name           string
+		set            func() DayTimeSet
+		expectedResult expectedResult
+	}{
+		{
+			name: "Checking standard work",
+			set: func() DayTimeSet {
+				return NewDayTimeSet(DayTime{hour: 17}, DayTime{hour: 9}, DayTime{hour: 12, minute: 30})
+			},
+			expectedResult: expectedResult{
+				sorted:   []DayTime{{hour: 9}, {hour: 12, minute: 30}, {hour: 17}},
+				contains: []DayTime{{hour: 9}, {hour: 12, minute: 30}, {hour: 17}, {hour: 9, nanosecond: 1}},
+				missing:  []DayTime{{hour: 10}, {hour: 9, second: 1}},
+			},
+		},
+		{
+			name: "Checking to add duplicates",
+			set: func() DayTimeSet {
+				set := DayTimeSet{}
+				set.Add(DayTime{hour: 9})
+				set.Add(DayTime{hour: 9})
+				set.Add(DayTime{hour: 8, minute: 59, second: 59})
+
+				return set
+			},
+			expectedResult: expectedResult{
+				sorted:   []DayTime{{hour: 8, minute: 59, second: 59}, {hour: 9}},
+				contains: []DayTime{{hour: 9}, {hour: 8, minute: 59, second: 59}},
+			},
+		},
+		{
+			name: "Checking to add fractional seconds",
+			set: func() DayTimeSet {
+				set := DayTimeSet{}
+				set.Add(DayTime{hour: 9})
+				set.Add(DayTime{hour: 9, nanosecond: 500})
+
+				return set
+			},
+			expectedResult: expectedResult{
+				sorted:   []DayTime{{hour: 9, nanosecond: 500}},
+				contains: []DayTime{{hour: 9}},
+			},
+		},
+		{
+			name: "Checking to remove",
+			set: func() DayTimeSet {
+				set := NewDayTimeSet(DayTime{hour: 9}, DayTime{hour: 17})
+				set.Remove(DayTime{hour: 9})
+				set.Remove(DayTime{hour: 10})
+
+				return set
+			},
+			expectedResult: expectedResult{
+				sorted:   []DayTime{{hour: 17}},
+				contains: []DayTime{{hour: 17}},
+				missing:  []DayTime{{hour: 9}},
+			},
+		},
+		{
+			name: "Checking to process the zero value",
+			set: func() DayTimeSet {
+				set := DayTimeSet{}
+				set.Remove(DayTime{hour: 9})
+
+				return set
+			},
+			expectedResult: expectedResult{
+				sorted:  []DayTime{},
+				missing: []DayTime{{}},
+			},
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+
+			set := test.set()
+			assert.EqualValues(tt, test.expectedResult.sorted, set.Sorted())
+			assert.EqualValues(tt, len(test.expectedResult.sorted), set.Len())
+			for _, value := range test.expectedResult.contains {
+				assert.True(tt, set.Contains(value), value.String())
+			}
+			for _, value := range test.expectedResult.missing {
+				assert.False(tt, set.Contains(value), value.String())
+			}
+		})
+	}
+}
+
+func TestDayTimeSetNearest(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		target DayTime
+		wrap   bool
+	}
+	type expectedResult struct {
+		daytime DayTime
+		ok      bool
+	}
+	set := NewDayTimeSet(DayTime{minute: 10}, DayTime{hour: 9}, DayTime{hour: 12}, DayTime{hour: 23})
+	tests := []struct {
+		name           string
+		set            DayTimeSet
+		args           args
+		expectedResult expectedResult
+	}{
+		{
+			name: "Checking standard work",
+			set:  set,
+			args: args{
+				target: DayTime{hour: 11},
+			},
+			expectedResult: expectedResult{
+				daytime: DayTime{hour: 12},
+				ok:      true,
+			},
+		},
+		{
+			name: "Checking the processing of a tie",
+			set:  set,
+			args: args{
+				target: DayTime{hour: 10, minute: 30},
+			},
+			expectedResult: expectedResult{
+				daytime: DayTime{hour: 9},
+				ok:      true,
+			},
+		},
+		{
+			name: "Checking the processing of an exact match",
+			set:  set,
+			args: args{
+				target: DayTime{hour: 9},
+			},
+			expectedResult: expectedResult{
+				daytime: DayTime{hour: 9},
+				ok:      true,
+			},
+		},
+		{
+			name: "Checking the processing of wrapping",
+			set:  NewDayTimeSet(DayTime{minute: 10}, DayTime{hour: 22}),
+			args: args{
+				target: DayTime{hour: 23, minute: 50},
+				wrap:   true,
+			},
+			expectedResult: expectedResult{
+				daytime: DayTime{minute: 10},
+				ok:      true,
+			},
+		},
+		{
+			name: "Checking the processing of no wrapping",
+			set:  NewDayTimeSet(DayTime{minute: 10}, DayTime{hour: 22}),
+			args: args{
+				target: DayTime{hour: 23, minute: 50},
+			},
+			expectedResult: expectedResult{
+				daytime: DayTime{hour: 22},
+				ok:      true,
+			},
+		},
+		{
+			name: "Checking to process an empty set",
+			set:  DayTimeSet{},
+			args: args{
+				target: DayTime{hour: 9},
+			},
+			expectedResult: expectedResult{},
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+
+			nearest := test.set.Nearest
+			if test.args.wrap {
+				nearest = test.set.NearestWrap
+			}
+
+			value, ok := nearest(test.args.target)
+			assert.EqualValues(tt, test.expectedResult.daytime, value)
+			assert.EqualValues(tt, test.expectedResult.ok, ok)
+		})
+	}
+}
+
+func TestSnapToNearest(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		ts      time.Time
+		allowed []DayTime
+	}
+	type expectedResult struct {
+		daytime DayTime
+		ok      bool
+	}
+	allowed := []DayTime{{hour: 9}, {hour: 12}, {hour: 22}}
+	tests := []struct {
+		name           string
+		args           args
+		expectedResult expectedResult
+	}{
+		{
+			name: "Checking standard work",
+			args: args{
+				ts:      time.Date(2024, time.March, 15, 11, 20, 0, 0, time.UTC),
+				allowed: allowed,
+			},
+			expectedResult: expectedResult{
+				daytime: DayTime{hour: 12},
+				ok:      true,
+			},
+		},
+		{
+			name: "Checking the processing of a tie",
+			args: args{
+				ts:      time.Date(2024, time.March, 15, 10, 30, 0, 0, time.UTC),
+				allowed: allowed,
+			},
+			expectedResult: expectedResult{
+				daytime: DayTime{hour: 9},
+				ok:      true,
+			},
+		},
+		{
+			name: "Checking the processing of wrapping",
+			args: args{
+				ts:      time.Date(2024, time.March, 15, 2, 0, 0, 0, time.UTC),
+				allowed: allowed,
+			},
+			expectedResult: expectedResult{
+				daytime: DayTime{hour: 22},
+				ok:      true,
+			},
+		},
+		{
+			name: "Checking to process an empty list",
+			args: args{
+				ts:      time.Date(2024, time.March, 15, 2, 0, 0, 0, time.UTC),
+				allowed: nil,
+			},
+			expectedResult: expectedResult{
+				daytime: DayTime{},
+				ok:      false,
+			},
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+
+			value, ok := SnapToNearest(test.args.ts, test.args.allowed)
+			assert.EqualValues(tt, test.expectedResult.daytime, value)
+			assert.EqualValues(tt, test.expectedResult.ok, ok)
+		})
+	}
+}
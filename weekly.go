@@ -0,0 +1,65 @@
+package daytime
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// WeeklyTime is a daytime on a day of the week, e.g. Mon 09:00.
+type WeeklyTime struct {
+	Day  time.Weekday
+	Time DayTime
+}
+
+// ParseWeekly parse a weekly time in the "Mon 09:00" form. The day is the
+// case-insensitive three-letter abbreviation, the daytime is parsed by Parse.
+func ParseWeekly(value string) (WeeklyTime, error) {
+	day, clock, found := strings.Cut(strings.Trim(value, " \t"), " ")
+	if !found {
+		return WeeklyTime{}, errors.Wrap(ErrInvalid, fmt.Sprintf("weekly value '%s'", value))
+	}
+
+	weekday, ok := parseWeekday(day)
+	if !ok {
+		return WeeklyTime{}, errors.Wrap(ErrInvalid, fmt.Sprintf("weekday '%s'", day))
+	}
+
+	daytime, err := Parse(clock)
+	if err != nil {
+		return WeeklyTime{}, errors.Wrap(err, "weekly daytime")
+	}
+
+	return WeeklyTime{Day: weekday, Time: daytime}, nil
+}
+
+// parseWeekday parses the three-letter abbreviation of a weekday.
+func parseWeekday(value string) (time.Weekday, bool) {
+	for weekday := time.Sunday; weekday <= time.Saturday; weekday++ {
+		if strings.EqualFold(value, weekday.String()[:3]) {
+			return weekday, true
+		}
+	}
+
+	return time.Sunday, false
+}
+
+// String convert to string in the "Mon 09:00" form.
+func (w WeeklyTime) String() string {
+	return w.Day.String()[:3] + " " + w.Time.String()
+}
+
+// NextOccurrence returns the soonest time strictly after the given time
+// on the weekday whose clock equals the daytime, in the location of after.
+func (w WeeklyTime) NextOccurrence(after time.Time) time.Time {
+	days := (int(w.Day) - int(after.Weekday()) + 7) % 7
+	datetime := w.Time.TimeOn(after.AddDate(0, 0, days))
+
+	if !datetime.After(after) {
+		datetime = w.Time.TimeOn(after.AddDate(0, 0, days+7))
+	}
+
+	return datetime
+}
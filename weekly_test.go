@@ -0,0 +1,150 @@
+package daytime
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseWeekly(t *testing.T) {
+	t.Parallel()
+
+	type expectedResult struct {
+		value WeeklyTime
+		err   error
+	}
+	tests := []struct {
+		name           string
+		value          string
+		expectedResult expectedResult
+	}{
+		{
+			name:  "Checking standard work",
+			value: "Mon 09:00",
+			expectedResult: expectedResult{
+				value: WeeklyTime{Day: time.Monday, Time: DayTime{hour: 9}},
+			},
+		},
+		{
+			name:  "Checking the processing of the case",
+			value: " sun 23:30:15 ",
+			expectedResult: expectedResult{
+				value: WeeklyTime{Day: time.Sunday, Time: DayTime{hour: 23, minute: 30, second: 15}},
+			},
+		},
+		{
+			name:  "Checking the processing of an unknown weekday",
+			value: "Mnd 09:00",
+			expectedResult: expectedResult{
+				err: ErrInvalid,
+			},
+		},
+		{
+			name:  "Checking the processing of an invalid daytime",
+			value: "Mon 24:00",
+			expectedResult: expectedResult{
+				err: ErrInvalid,
+			},
+		},
+		{
+			name:  "Checking the processing of a missing daytime",
+			value: "Mon",
+			expectedResult: expectedResult{
+				err: ErrInvalid,
+			},
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+
+			value, err := ParseWeekly(test.value)
+			assert.EqualValues(tt, test.expectedResult.value, value)
+			assert.ErrorIs(tt, err, test.expectedResult.err)
+		})
+	}
+}
+
+func TestWeeklyTimeString(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		value          WeeklyTime
+		expectedResult string
+	}{
+		{
+			name:           "Checking standard work",
+			value:          WeeklyTime{Day: time.Monday, Time: DayTime{hour: 9}},
+			expectedResult: "Mon 09:00",
+		},
+		{
+			name:           "Checking the processing of seconds",
+			value:          WeeklyTime{Day: time.Saturday, Time: DayTime{hour: 23, second: 5}},
+			expectedResult: "Sat 23:00:05",
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+
+			assert.EqualValues(tt, test.expectedResult, test.value.String())
+		})
+	}
+}
+
+func TestWeeklyTimeNextOccurrence(t *testing.T) {
+	t.Parallel()
+
+	// 2024-03-13 is a Wednesday.
+	wednesday := time.Date(2024, time.March, 13, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		value          WeeklyTime
+		after          time.Time
+		expectedResult time.Time
+	}{
+		{
+			name:           "Checking a later weekday",
+			value:          WeeklyTime{Day: time.Friday, Time: DayTime{hour: 9}},
+			after:          wednesday,
+			expectedResult: time.Date(2024, time.March, 15, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name:           "Checking an earlier weekday",
+			value:          WeeklyTime{Day: time.Monday, Time: DayTime{hour: 9}},
+			after:          wednesday,
+			expectedResult: time.Date(2024, time.March, 18, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name:           "Checking the same weekday later in the day",
+			value:          WeeklyTime{Day: time.Wednesday, Time: DayTime{hour: 18}},
+			after:          wednesday,
+			expectedResult: time.Date(2024, time.March, 13, 18, 0, 0, 0, time.UTC),
+		},
+		{
+			name:           "Checking the same weekday after the time has passed",
+			value:          WeeklyTime{Day: time.Wednesday, Time: DayTime{hour: 9}},
+			after:          wednesday,
+			expectedResult: time.Date(2024, time.March, 20, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name:           "Checking the same weekday at the exact time",
+			value:          WeeklyTime{Day: time.Wednesday, Time: DayTime{hour: 12}},
+			after:          wednesday,
+			expectedResult: time.Date(2024, time.March, 20, 12, 0, 0, 0, time.UTC),
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+
+			assert.EqualValues(tt, test.expectedResult, test.value.NextOccurrence(test.after))
+		})
+	}
+}